@@ -1,6 +1,10 @@
 package zeit
 
-import "time"
+import (
+	"fmt"
+	"slices"
+	"time"
+)
 
 // BillingInterval represents the frequency of billing cycles.
 type BillingInterval int
@@ -16,19 +20,81 @@ const (
 	Quarterly
 	// Yearly billing interval.
 	Yearly
+	// SemiAnnually bills every six months.
+	SemiAnnually
+)
+
+// PeriodKind labels what a period is billed as, so invoice code can switch on it.
+type PeriodKind int
+
+const (
+	// PeriodRegular is a normal billing period. It is the zero value.
+	PeriodRegular PeriodKind = iota
+	// PeriodTrial is a free or discounted trial period.
+	PeriodTrial
+	// PeriodGrace is a period of continued service after a missed payment.
+	PeriodGrace
+	// PeriodDunning is a period during which failed payments are retried.
+	PeriodDunning
 )
 
+// periodKindNames holds the text form of each PeriodKind, indexed by value.
+var periodKindNames = []string{"regular", "trial", "grace", "dunning"}
+
+// String returns the kind's name, e.g. "trial".
+func (k PeriodKind) String() string {
+	if k < 0 || int(k) >= len(periodKindNames) {
+		return fmt.Sprintf("PeriodKind(%d)", int(k))
+	}
+	return periodKindNames[k]
+}
+
+// MarshalText implements encoding.TextMarshaler, so kinds marshal to JSON as names.
+func (k PeriodKind) MarshalText() ([]byte, error) {
+	if k < 0 || int(k) >= len(periodKindNames) {
+		return nil, fmt.Errorf("%w: period kind %d", ErrInvalidFormat, int(k))
+	}
+	return []byte(periodKindNames[k]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (k *PeriodKind) UnmarshalText(text []byte) error {
+	i := slices.Index(periodKindNames, string(text))
+	if i < 0 {
+		return fmt.Errorf("%w: period kind %q", ErrInvalidFormat, text)
+	}
+	*k = PeriodKind(i)
+	return nil
+}
+
 // Period represents a time period with start and end times.
+// Periods are half-open: StartsAt is included, EndsAt is not.
+// A nil EndsAt marks an open-ended period that runs until further notice.
+// Kind labels the period; it is omitted from JSON for regular periods.
 type Period struct {
 	StartsAt *Zeit
 	EndsAt   *Zeit
+	Kind     PeriodKind `json:",omitempty"`
+
+	// partial marks a stub period that is shorter than a full cycle.
+	partial bool
 }
 
 // Cycles generates a series of billing periods starting from the Zeit.
 // count: number of periods to generate
 // interval: billing frequency (Daily, Weekly, Monthly, etc.)
+// Boundaries step on the UTC calendar, so in a timezone with DST the local
+// renewal time is an hour off for part of the year. Use CyclesLocal to keep it.
+// A negative count generates -count periods backwards from the Zeit: the last
+// period ends at the Zeit and the periods are in chronological order, so
+// z.Cycles(-3, Monthly) covers the three months before z. Each start is computed
+// from the Zeit with month ends clamped, so from May 31 they are Apr 30, Mar 31
+// and Feb 29. A zero count returns an empty slice.
 func (z *Zeit) Cycles(count int, interval BillingInterval) []*Period {
-	if count <= 0 {
+	if count < 0 {
+		return z.cyclesBackwards(-count, interval)
+	}
+	if count == 0 {
 		return []*Period{}
 	}
 
@@ -36,22 +102,312 @@ func (z *Zeit) Cycles(count int, interval BillingInterval) []*Period {
 	current := z
 
 	for i := range count {
-		var next *Zeit
-
-		switch interval {
-		case Daily:
-			next = current.AddDays(1)
-		case Weekly:
-			next = current.AddDays(7)
-		case Monthly:
-			next = New(current.instant.AddDate(0, 1, 0), current.location)
-		case Quarterly:
-			next = New(current.instant.AddDate(0, 3, 0), current.location)
-		case Yearly:
-			next = New(current.instant.AddDate(1, 0, 0), current.location)
-		default:
-			next = current.AddDays(1)
+		next := interval.next(current)
+
+		periods[i] = &Period{
+			StartsAt: current,
+			EndsAt:   next,
+		}
+
+		current = next
+	}
+
+	return periods
+}
+
+// cyclesBackwards generates count periods ending at the Zeit, in chronological order.
+func (z *Zeit) cyclesBackwards(count int, interval BillingInterval) []*Period {
+	periods := make([]*Period, count)
+	current := z
+
+	for i := range count {
+		prev := New(interval.stepBack(z.instant, i+1), z.location)
+
+		periods[count-1-i] = &Period{
+			StartsAt: prev,
+			EndsAt:   current,
+		}
+
+		current = prev
+	}
+
+	return periods
+}
+
+// CyclesLocal is like Cycles but steps on the Zeit's local calendar, so renewals
+// keep their local wall-clock time across DST changes: a plan starting at 00:00
+// in Berlin renews at 00:00 Berlin time in summer and winter alike. Each boundary
+// is computed from the Zeit like CyclesEvery, so month-end starts clamp without
+// drifting (Jan 31, Feb 29, Mar 31, ...). A negative count generates periods
+// backwards like Cycles. A zero count returns an empty slice.
+func (z *Zeit) CyclesLocal(count int, interval BillingInterval) []*Period {
+	return z.CyclesEvery(count, interval.Span())
+}
+
+// CyclesToReach returns how many billing cycles from start begin before target,
+// stepping like Cycles, for lifetime-value projections: start.Cycles(n, interval)
+// with that n is the shortest series that runs until target. The first cycle begins
+// at start, so the number of renewals before target is one less.
+// Returns 0 if target is nil or not after start.
+func CyclesToReach(start *Zeit, interval BillingInterval, target *Zeit) int {
+	if target == nil {
+		return 0
+	}
+
+	n := 0
+	for current := start.instant; current.Before(target.instant); current = interval.advance(current) {
+		n++
+	}
+	return n
+}
+
+// CyclesInto is like Cycles but appends the periods to dst as values and returns
+// the extended slice, for high-volume generation that reuses buffers:
+//
+//	buf = z.CyclesInto(buf[:0], 12, zeit.Monthly)
+//
+// All period boundaries share a single allocation, and adjacent periods share
+// boundary pointers as with Cycles. No Period is allocated when dst has capacity.
+// Unlike Cycles, a count that is not positive appends nothing.
+func (z *Zeit) CyclesInto(dst []Period, count int, interval BillingInterval) []Period {
+	if count <= 0 {
+		return dst
+	}
+
+	boundaries := make([]Zeit, count)
+	dst = slices.Grow(dst, count)
+	current := z
+
+	for i := range count {
+		boundaries[i] = Zeit{instant: interval.advance(current.instant), location: current.location}
+		next := &boundaries[i]
+
+		dst = append(dst, Period{
+			StartsAt: current,
+			EndsAt:   next,
+		})
+
+		current = next
+	}
+
+	return dst
+}
+
+// CyclesUntil generates consecutive billing periods from the Zeit until end, stepping
+// like Cycles. The last period is the one containing end. With clip it is cut off
+// at end and marked partial if shortened; without clip it runs a full cycle past end.
+// Returns an empty slice if end is nil or not after the Zeit.
+func (z *Zeit) CyclesUntil(end *Zeit, interval BillingInterval, clip bool) []*Period {
+	periods := []*Period{}
+	if end == nil || !z.Before(end) {
+		return periods
+	}
+
+	for current := z; current.Before(end); {
+		next := interval.next(current)
+		period := &Period{
+			StartsAt: current,
+			EndsAt:   next,
+		}
+		if clip && next.After(end) {
+			period.EndsAt = end
+			period.partial = true
+		}
+
+		periods = append(periods, period)
+		current = next
+	}
+
+	return periods
+}
+
+// MonthsUntil splits the time from the Zeit until end into one period per calendar
+// month in the Zeit's timezone, for statement generation loops. Periods break at
+// local midnight on the 1st; the first starts at the Zeit and the last ends at end,
+// and either is marked partial if it doesn't cover its whole month.
+// Returns an empty slice if end is nil or not after the Zeit.
+func (z *Zeit) MonthsUntil(end *Zeit) []*Period {
+	periods := []*Period{}
+	if end == nil || !z.Before(end) {
+		return periods
+	}
+
+	for current := z; current.Before(end); {
+		next := current.EndOfMonthExclusive()
+
+		period := &Period{
+			StartsAt: current,
+			EndsAt:   next,
+			partial:  !current.Equal(current.StartOfMonth()),
 		}
+		if next.After(end) {
+			period.EndsAt = end
+			period.partial = true
+		}
+
+		periods = append(periods, period)
+		current = next
+	}
+
+	return periods
+}
+
+// next returns the start of the cycle following the one starting at current.
+func (i BillingInterval) next(current *Zeit) *Zeit {
+	return New(i.advance(current.instant), current.location)
+}
+
+// advance returns the UTC instant one interval after t.
+func (i BillingInterval) advance(t time.Time) time.Time {
+	switch i {
+	case Weekly:
+		return t.AddDate(0, 0, 7)
+	case Monthly:
+		return t.AddDate(0, 1, 0)
+	case Quarterly:
+		return t.AddDate(0, 3, 0)
+	case Yearly:
+		return t.AddDate(1, 0, 0)
+	case SemiAnnually:
+		return t.AddDate(0, 6, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+// advanceBy returns the UTC instant n intervals after t, the start of period n of
+// Cycles, without stepping through the periods in between. Stepping only differs
+// from one big step while the day is past the 28th: the first month too short for
+// it moves the day into the next month for good, and a day that no month within
+// two years of steps overflows never overflows.
+func (i BillingInterval) advanceBy(t time.Time, n int) time.Time {
+	for step := 0; step < 24 && n > 0 && t.Day() > 28; step++ {
+		t = i.advance(t)
+		n--
+	}
+
+	span := i.Span()
+	switch span.Unit {
+	case Months:
+		return t.AddDate(0, n*span.Count, 0)
+	case Years:
+		return t.AddDate(n*span.Count, 0, 0)
+	case Weeks:
+		return t.AddDate(0, 0, 7*n*span.Count)
+	default:
+		return t.AddDate(0, 0, n*span.Count)
+	}
+}
+
+// stepBack returns the UTC instant n intervals before t, computed in one step on the
+// UTC calendar with month ends clamped to the last day of the target month.
+func (i BillingInterval) stepBack(t time.Time, n int) time.Time {
+	span := i.Span()
+	switch span.Unit {
+	case Months:
+		return shiftDate(t, -n*span.Count, 0, true)
+	case Years:
+		return shiftDate(t, -12*n*span.Count, 0, true)
+	case Weeks:
+		return t.AddDate(0, 0, -7*n*span.Count)
+	default:
+		return t.AddDate(0, 0, -n*span.Count)
+	}
+}
+
+// Span returns the interval as a CalendarSpan, e.g. Span(3, Months) for Quarterly.
+// Unknown intervals map to one day, as in Cycles.
+func (i BillingInterval) Span() CalendarSpan {
+	switch i {
+	case Weekly:
+		return Span(1, Weeks)
+	case Monthly:
+		return Span(1, Months)
+	case Quarterly:
+		return Span(3, Months)
+	case Yearly:
+		return Span(1, Years)
+	case SemiAnnually:
+		return Span(6, Months)
+	default:
+		return Span(1, Days)
+	}
+}
+
+// yearUnits is the length of the average Gregorian year (365.2425 days) in units
+// of 1/4800 day, the finest unit in which every BillingInterval is a whole number.
+const yearUnits = 1753164
+
+// units returns the nominal length of the interval in 1/4800 day: a month is a
+// twelfth of the average Gregorian year. Unknown intervals map to one day.
+func (i BillingInterval) units() int64 {
+	switch i {
+	case Weekly:
+		return 7 * 4800
+	case Monthly:
+		return yearUnits / 12
+	case Quarterly:
+		return yearUnits / 4
+	case Yearly:
+		return yearUnits
+	case SemiAnnually:
+		return yearUnits / 2
+	default:
+		return 4800
+	}
+}
+
+// PeriodsPerYear returns how many periods of the interval fit into a year:
+// 12 for Monthly, 4 for Quarterly, 2 for SemiAnnually and 1 for Yearly.
+// Daily and Weekly are measured against the average Gregorian year of 365.2425
+// days, giving 365.2425 and 52.1775.
+func (i BillingInterval) PeriodsPerYear() float64 {
+	return float64(yearUnits) / float64(i.units())
+}
+
+// ConvertCount converts a number of periods of one interval into periods of
+// another, rounding partial periods by mode, for plan-change previews:
+// ConvertCount(18, Monthly, Yearly, RoundTruncate) is 1, with RoundUp it is 2.
+// Month-based intervals convert exactly; conversions between days or weeks and
+// months use the average Gregorian month of 30.436875 days, so
+// ConvertCount(1, Monthly, Daily, RoundNearest) is 30.
+// Negative counts are rounded like their absolute value and keep their sign.
+func ConvertCount(count int, from, to BillingInterval, mode RoundingMode) int {
+	if count < 0 {
+		return -ConvertCount(-count, from, to, mode)
+	}
+	raw := time.Duration(int64(count) * from.units())
+	return roundUnits(raw, time.Duration(to.units()), mode)
+}
+
+// ConvertRate converts a price per period of one interval into the equivalent
+// price per period of another: ConvertRate(10, Monthly, Yearly) is 120.
+// Daily and Weekly rates are scaled by the average Gregorian year like
+// PeriodsPerYear. The result is not rounded; apply currency rounding yourself.
+func ConvertRate(amount float64, from, to BillingInterval) float64 {
+	return amount * float64(to.units()) / float64(from.units())
+}
+
+// CyclesEvery generates count consecutive periods of the given span starting from the Zeit,
+// e.g. with a span from ParseInterval("every 2 weeks").
+// Each boundary is computed from the Zeit rather than the previous boundary, so
+// month-end starts don't drift (Jan 31, Feb 29, Mar 31, ...).
+// A negative count generates -count periods backwards like Cycles, the last
+// ending at the Zeit. Returns an empty slice if count is zero or the span's
+// count is not positive.
+func (z *Zeit) CyclesEvery(count int, span CalendarSpan) []*Period {
+	if count == 0 || span.Count <= 0 {
+		return []*Period{}
+	}
+	if count < 0 {
+		return z.cyclesEveryBackwards(-count, span)
+	}
+
+	periods := make([]*Period, count)
+	current := z
+
+	for i := range count {
+		next := z.AddSpan(Span((i+1)*span.Count, span.Unit))
 
 		periods[i] = &Period{
 			StartsAt: current,
@@ -64,12 +420,240 @@ func (z *Zeit) Cycles(count int, interval BillingInterval) []*Period {
 	return periods
 }
 
+// cyclesEveryBackwards generates count periods of span ending at the Zeit, in
+// chronological order, each start computed from the Zeit.
+func (z *Zeit) cyclesEveryBackwards(count int, span CalendarSpan) []*Period {
+	periods := make([]*Period, count)
+	current := z
+
+	for i := range count {
+		prev := z.AddSpan(Span(-(i+1)*span.Count, span.Unit))
+
+		periods[count-1-i] = &Period{
+			StartsAt: prev,
+			EndsAt:   current,
+		}
+
+		current = prev
+	}
+
+	return periods
+}
+
 // Duration calculates the time difference between start and end of a period.
+// For open-ended periods it measures the time elapsed since StartsAt, using the
+// same corrected clock as Now.
 func (p *Period) Duration() time.Duration {
+	if p.EndsAt == nil {
+		return now().Sub(p.StartsAt.instant)
+	}
 	return p.EndsAt.instant.Sub(p.StartsAt.instant)
 }
 
+// IsPartial reports whether the period is a stub that is shorter than a full
+// billing cycle, such as the first period of an anchored cycle series or the last
+// period of CyclesUntil when clipped at its end.
+// Invoice generation should prorate partial periods.
+func (p *Period) IsPartial() bool {
+	return p.partial
+}
+
 // Contains checks if a Zeit falls within the period.
 func (p *Period) Contains(z *Zeit) bool {
-	return !z.Before(p.StartsAt) && z.Before(p.EndsAt)
+	if z.Before(p.StartsAt) {
+		return false
+	}
+	return p.EndsAt == nil || z.Before(p.EndsAt)
+}
+
+// CyclesAligned generates billing periods aligned to calendar boundaries of the interval
+// in the Zeit's timezone: Quarterly renews on Jan 1, Apr 1, Jul 1 and Oct 1,
+// SemiAnnually on Jan 1 and Jul 1, Yearly on Jan 1, Monthly on the 1st, Weekly on
+// Monday and Daily at midnight. The first period runs from the Zeit to the next
+// boundary. It is shorter than a full cycle, and marked partial, unless the Zeit
+// already sits on a boundary. The count includes this first period.
+// A negative count generates -count periods backwards, in chronological order:
+// the last runs from the preceding boundary to the Zeit and is marked partial
+// unless the Zeit sits on a boundary. A zero count returns an empty slice.
+func (z *Zeit) CyclesAligned(count int, interval BillingInterval) []*Period {
+	if count < 0 {
+		return z.cyclesAlignedBackwards(-count, interval)
+	}
+	if count == 0 {
+		return []*Period{}
+	}
+
+	local := z.Time()
+	periods := make([]*Period, count)
+	current := z
+
+	for i := range count {
+		next := New(interval.alignedBoundary(local, i+1), z.location)
+
+		periods[i] = &Period{
+			StartsAt: current,
+			EndsAt:   next,
+		}
+
+		current = next
+	}
+
+	periods[0].partial = !local.Equal(interval.alignedBoundary(local, 0))
+
+	return periods
+}
+
+// cyclesAlignedBackwards generates count aligned periods ending at the Zeit, in
+// chronological order.
+func (z *Zeit) cyclesAlignedBackwards(count int, interval BillingInterval) []*Period {
+	local := z.Time()
+	last := 0
+	if local.Equal(interval.alignedBoundary(local, 0)) {
+		last = -1
+	}
+
+	periods := make([]*Period, count)
+	current := z
+
+	for i := range count {
+		prev := New(interval.alignedBoundary(local, last-i), z.location)
+
+		periods[count-1-i] = &Period{
+			StartsAt: prev,
+			EndsAt:   current,
+		}
+
+		current = prev
+	}
+
+	periods[count-1].partial = last == 0
+
+	return periods
+}
+
+// alignedBoundary returns the k-th calendar boundary of the interval after the
+// one starting the aligned cycle that contains t, as midnight in t's location.
+// k = 0 is the start of that cycle.
+func (i BillingInterval) alignedBoundary(t time.Time, k int) time.Time {
+	day := startOfDay(t)
+
+	var months int
+	switch i {
+	case Weekly:
+		start := ISOWeek.weekStart(day)
+		return time.Date(start.Year(), start.Month(), start.Day()+7*k, 0, 0, 0, 0, t.Location())
+	case Monthly:
+		months = 1
+	case Quarterly:
+		months = 3
+	case SemiAnnually:
+		months = 6
+	case Yearly:
+		months = 12
+	default:
+		return time.Date(day.Year(), day.Month(), day.Day()+k, 0, 0, 0, 0, t.Location())
+	}
+
+	month := int(day.Month()) - 1
+	month -= month % months
+	return time.Date(day.Year(), time.Month(month+1+k*months), 1, 0, 0, 0, 0, t.Location())
+}
+
+// CyclesAnchoredWeekly generates weekly billing periods that renew on the given weekday.
+// Renewals fall at midnight in the Zeit's timezone. The first period runs from the Zeit
+// to the first anchor day. It is shorter than a week, and marked partial, unless the
+// Zeit already sits at midnight on that weekday. The count includes this first period.
+// Every later period, including the last, ends on an anchor day and is never partial;
+// to end the series with a stub at a cancellation date, clip it as CyclesUntil does.
+func (z *Zeit) CyclesAnchoredWeekly(count int, weekday time.Weekday) []*Period {
+	if count <= 0 {
+		return []*Period{}
+	}
+
+	local := z.Time()
+	day := startOfDay(local)
+	offset := (int(weekday) - int(day.Weekday()) + 7) % 7
+	if offset == 0 {
+		offset = 7
+	}
+
+	periods := make([]*Period, count)
+	current := z
+
+	for i := range count {
+		next := New(time.Date(day.Year(), day.Month(), day.Day()+offset+7*i, 0, 0, 0, 0, z.location), z.location)
+
+		periods[i] = &Period{
+			StartsAt: current,
+			EndsAt:   next,
+		}
+
+		current = next
+	}
+
+	periods[0].partial = !local.Equal(day) || day.Weekday() != weekday
+
+	return periods
+}
+
+// LeapDayPolicy decides where a Feb 29 anchor falls in non-leap years.
+type LeapDayPolicy int
+
+const (
+	// LeapDayFeb28 renews on Feb 28 in non-leap years.
+	LeapDayFeb28 LeapDayPolicy = iota
+	// LeapDayMar1 renews on Mar 1 in non-leap years.
+	LeapDayMar1
+)
+
+// CyclesAnchoredYearly generates yearly billing periods that renew on a fixed calendar date.
+// Renewals fall at midnight in the Zeit's timezone. The first period runs from the Zeit
+// to the next anchor date. It is shorter than a year, and marked partial, unless the
+// Zeit already sits at midnight on the anchor. The count includes this first period.
+// Every later period, including the last, ends on an anchor date and is never partial;
+// to end the series with a stub at a cancellation date, clip it as CyclesUntil does.
+// A Feb 29 anchor is moved according to policy in non-leap years; other days beyond
+// the end of the month are clamped to its last day.
+func (z *Zeit) CyclesAnchoredYearly(count int, month time.Month, day int, policy LeapDayPolicy) []*Period {
+	if count <= 0 {
+		return []*Period{}
+	}
+
+	local := z.Time()
+	year := local.Year()
+	onAnchor := anchorDate(year, month, day, policy, z.location).Equal(local)
+	if !anchorDate(year, month, day, policy, z.location).After(local) {
+		year++
+	}
+
+	periods := make([]*Period, count)
+	current := z
+
+	for i := range count {
+		next := New(anchorDate(year+i, month, day, policy, z.location), z.location)
+
+		periods[i] = &Period{
+			StartsAt: current,
+			EndsAt:   next,
+		}
+
+		current = next
+	}
+
+	periods[0].partial = !onAnchor
+
+	return periods
+}
+
+// anchorDate returns midnight of the anchor day in the given year.
+// Days beyond the end of the month are clamped, except Feb 29 which follows policy.
+func anchorDate(year int, month time.Month, day int, policy LeapDayPolicy, loc *time.Location) time.Time {
+	last := daysIn(year, month)
+	if day > last {
+		if month == time.February && day == 29 && policy == LeapDayMar1 {
+			return time.Date(year, time.March, 1, 0, 0, 0, 0, loc)
+		}
+		day = last
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
 }
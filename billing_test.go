@@ -1,6 +1,8 @@
 package zeit
 
 import (
+	"encoding/json"
+	"math"
 	"testing"
 	"time"
 )
@@ -162,11 +164,169 @@ func TestCycles_ZeroCount(t *testing.T) {
 }
 
 func TestCycles_NegativeCount(t *testing.T) {
-	z := Now(time.UTC)
-	periods := z.Cycles(-5, Daily)
+	end := time.Date(2024, 4, 15, 10, 0, 0, 0, time.UTC)
+	z := New(end, time.UTC)
 
-	if len(periods) != 0 {
-		t.Errorf("Expected 0 periods for negative count, got %d", len(periods))
+	tests := []struct {
+		firstStart time.Time
+		name       string
+		interval   BillingInterval
+		count      int
+	}{
+		{name: "Daily", count: -5, interval: Daily, firstStart: time.Date(2024, 4, 10, 10, 0, 0, 0, time.UTC)},
+		{name: "Weekly", count: -2, interval: Weekly, firstStart: time.Date(2024, 4, 1, 10, 0, 0, 0, time.UTC)},
+		{name: "Monthly", count: -3, interval: Monthly, firstStart: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)},
+		{name: "Quarterly", count: -1, interval: Quarterly, firstStart: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)},
+		{name: "Yearly", count: -2, interval: Yearly, firstStart: time.Date(2022, 4, 15, 10, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods := z.Cycles(tt.count, tt.interval)
+			if len(periods) != -tt.count {
+				t.Fatalf("Expected %d periods, got %d", -tt.count, len(periods))
+			}
+			if !periods[0].StartsAt.instant.Equal(tt.firstStart) {
+				t.Errorf("Expected first start %v, got %v", tt.firstStart, periods[0].StartsAt.instant)
+			}
+			if periods[len(periods)-1].EndsAt != z {
+				t.Errorf("Expected last period to end at %v, got %v", end, periods[len(periods)-1].EndsAt.instant)
+			}
+			for i := 1; i < len(periods); i++ {
+				if periods[i].StartsAt != periods[i-1].EndsAt {
+					t.Errorf("Period %d should start where period %d ends", i, i-1)
+				}
+			}
+		})
+	}
+}
+
+func TestCycles_NegativeCount_MirrorsForward(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := New(time.Date(2024, 1, 15, 0, 0, 0, 0, berlin), berlin)
+	forward := start.Cycles(6, Monthly)
+	backward := forward[len(forward)-1].EndsAt.Cycles(-6, Monthly)
+
+	for i := range forward {
+		if !backward[i].Equal(forward[i]) {
+			t.Errorf("Period %d: expected %v, got %v", i, forward[i].ISO8601(), backward[i].ISO8601())
+		}
+		if backward[i].StartsAt.Location() != berlin {
+			t.Errorf("Period %d StartsAt timezone not preserved", i)
+		}
+	}
+}
+
+func TestCycles_NegativeCount_MonthEnd(t *testing.T) {
+	at := func(year, month, day int) time.Time {
+		return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		end      time.Time
+		starts   []time.Time
+		name     string
+		interval BillingInterval
+	}{
+		{name: "Monthly from May 31", end: at(2024, 5, 31), interval: Monthly, starts: []time.Time{at(2024, 2, 29), at(2024, 3, 31), at(2024, 4, 30)}},
+		{name: "Quarterly from May 31", end: at(2024, 5, 31), interval: Quarterly, starts: []time.Time{at(2023, 11, 30), at(2024, 2, 29)}},
+		{name: "Yearly from Feb 29", end: at(2024, 2, 29), interval: Yearly, starts: []time.Time{at(2023, 2, 28)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := New(tt.end, time.UTC)
+			periods := z.Cycles(-len(tt.starts), tt.interval)
+			if len(periods) != len(tt.starts) {
+				t.Fatalf("Expected %d periods, got %d", len(tt.starts), len(periods))
+			}
+			for i, want := range tt.starts {
+				if !periods[i].StartsAt.instant.Equal(want) {
+					t.Errorf("Period %d: expected start %v, got %v", i, want, periods[i].StartsAt.instant)
+				}
+			}
+			if periods[len(periods)-1].EndsAt != z {
+				t.Error("Last period should end at the Zeit")
+			}
+		})
+	}
+}
+
+func TestCyclesEvery_NegativeCount(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := New(time.Date(2024, 5, 31, 0, 0, 0, 0, berlin), berlin)
+	expected := []time.Time{
+		time.Date(2024, 2, 29, 0, 0, 0, 0, berlin),
+		time.Date(2024, 3, 31, 0, 0, 0, 0, berlin),
+		time.Date(2024, 4, 30, 0, 0, 0, 0, berlin),
+	}
+
+	tests := []struct {
+		periods []*Period
+		name    string
+	}{
+		{name: "CyclesEvery", periods: z.CyclesEvery(-3, Span(1, Months))},
+		{name: "CyclesLocal", periods: z.CyclesLocal(-3, Monthly)},
+	}
+
+	for _, tt := range tests {
+		name, periods := tt.name, tt.periods
+		if len(periods) != len(expected) {
+			t.Fatalf("%s: expected %d periods, got %d", name, len(expected), len(periods))
+		}
+		for i, want := range expected {
+			if !periods[i].StartsAt.instant.Equal(want) {
+				t.Errorf("%s period %d: expected start %v, got %v", name, i, want, periods[i].StartsAt.Time())
+			}
+			if i > 0 && periods[i].StartsAt != periods[i-1].EndsAt {
+				t.Errorf("%s period %d should start where period %d ends", name, i, i-1)
+			}
+		}
+		if periods[len(periods)-1].EndsAt != z {
+			t.Errorf("%s: last period should end at the Zeit", name)
+		}
+	}
+}
+
+func TestCyclesAligned_NegativeCount(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	at := func(month, day, hour int) time.Time {
+		return time.Date(2024, time.Month(month), day, hour, 0, 0, 0, berlin)
+	}
+
+	tests := []struct {
+		end     time.Time
+		starts  []time.Time
+		name    string
+		partial bool
+	}{
+		{name: "Mid-month", end: at(5, 15, 12), starts: []time.Time{at(3, 1, 0), at(4, 1, 0), at(5, 1, 0)}, partial: true},
+		{name: "On a boundary", end: at(5, 1, 0), starts: []time.Time{at(2, 1, 0), at(3, 1, 0), at(4, 1, 0)}, partial: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := New(tt.end, berlin)
+			periods := z.CyclesAligned(-len(tt.starts), Monthly)
+			if len(periods) != len(tt.starts) {
+				t.Fatalf("Expected %d periods, got %d", len(tt.starts), len(periods))
+			}
+			for i, want := range tt.starts {
+				if !periods[i].StartsAt.instant.Equal(want) {
+					t.Errorf("Period %d: expected start %v, got %v", i, want, periods[i].StartsAt.Time())
+				}
+				if i < len(periods)-1 && periods[i].IsPartial() {
+					t.Errorf("Period %d should be a full cycle", i)
+				}
+			}
+			last := periods[len(periods)-1]
+			if last.EndsAt != z {
+				t.Error("Last period should end at the Zeit")
+			}
+			if last.IsPartial() != tt.partial {
+				t.Errorf("Expected last period partial %v, got %v", tt.partial, last.IsPartial())
+			}
+		})
 	}
 }
 
@@ -260,7 +420,7 @@ func TestCycles_Continuity(t *testing.T) {
 	start := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
 	z := New(start, time.UTC)
 
-	intervals := []BillingInterval{Daily, Weekly, Monthly, Quarterly, Yearly}
+	intervals := []BillingInterval{Daily, Weekly, Monthly, Quarterly, Yearly, SemiAnnually}
 
 	for _, interval := range intervals {
 		t.Run(interval.String(), func(t *testing.T) {
@@ -287,7 +447,851 @@ func (bi BillingInterval) String() string {
 		return "Quarterly"
 	case Yearly:
 		return "Yearly"
+	case SemiAnnually:
+		return "SemiAnnually"
 	default:
 		return "Unknown"
 	}
 }
+
+func TestCycles_SemiAnnually(t *testing.T) {
+	z := New(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	periods := z.Cycles(2, SemiAnnually)
+
+	expected := []time.Time{
+		time.Date(2024, 7, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+	for i, p := range periods {
+		if !p.EndsAt.instant.Equal(expected[i]) {
+			t.Errorf("Period %d end: expected %v, got %v", i, expected[i], p.EndsAt.instant)
+		}
+	}
+}
+
+func TestCyclesAligned(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		start    time.Time
+		name     string
+		ends     []time.Time
+		interval BillingInterval
+		partial  bool
+	}{
+		{
+			name:     "Quarterly from mid-quarter",
+			start:    time.Date(2024, 2, 15, 10, 0, 0, 0, berlin),
+			interval: Quarterly,
+			ends: []time.Time{
+				time.Date(2024, 4, 1, 0, 0, 0, 0, berlin),
+				time.Date(2024, 7, 1, 0, 0, 0, 0, berlin),
+				time.Date(2024, 10, 1, 0, 0, 0, 0, berlin),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, berlin),
+			},
+			partial: true,
+		},
+		{
+			name:     "Quarterly on a quarter start",
+			start:    time.Date(2024, 10, 1, 0, 0, 0, 0, berlin),
+			interval: Quarterly,
+			ends: []time.Time{
+				time.Date(2025, 1, 1, 0, 0, 0, 0, berlin),
+				time.Date(2025, 4, 1, 0, 0, 0, 0, berlin),
+			},
+		},
+		{
+			name:     "SemiAnnually",
+			start:    time.Date(2024, 8, 20, 0, 0, 0, 0, berlin),
+			interval: SemiAnnually,
+			ends: []time.Time{
+				time.Date(2025, 1, 1, 0, 0, 0, 0, berlin),
+				time.Date(2025, 7, 1, 0, 0, 0, 0, berlin),
+			},
+			partial: true,
+		},
+		{
+			name:     "Yearly",
+			start:    time.Date(2024, 3, 1, 0, 0, 0, 0, berlin),
+			interval: Yearly,
+			ends: []time.Time{
+				time.Date(2025, 1, 1, 0, 0, 0, 0, berlin),
+				time.Date(2026, 1, 1, 0, 0, 0, 0, berlin),
+			},
+			partial: true,
+		},
+		{
+			name:     "Monthly",
+			start:    time.Date(2024, 1, 31, 12, 0, 0, 0, berlin),
+			interval: Monthly,
+			ends: []time.Time{
+				time.Date(2024, 2, 1, 0, 0, 0, 0, berlin),
+				time.Date(2024, 3, 1, 0, 0, 0, 0, berlin),
+			},
+			partial: true,
+		},
+		{
+			name:     "Weekly on Mondays",
+			start:    time.Date(2024, 1, 17, 10, 0, 0, 0, berlin),
+			interval: Weekly,
+			ends: []time.Time{
+				time.Date(2024, 1, 22, 0, 0, 0, 0, berlin),
+				time.Date(2024, 1, 29, 0, 0, 0, 0, berlin),
+			},
+			partial: true,
+		},
+		{
+			name:     "Daily across DST",
+			start:    time.Date(2024, 3, 30, 0, 0, 0, 0, berlin),
+			interval: Daily,
+			ends: []time.Time{
+				time.Date(2024, 3, 31, 0, 0, 0, 0, berlin),
+				time.Date(2024, 4, 1, 0, 0, 0, 0, berlin),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := New(tt.start, berlin)
+			periods := start.CyclesAligned(len(tt.ends), tt.interval)
+
+			if len(periods) != len(tt.ends) {
+				t.Fatalf("Expected %d periods, got %d", len(tt.ends), len(periods))
+			}
+			if !periods[0].StartsAt.Equal(start) {
+				t.Error("First period should start at the Zeit")
+			}
+			for i, p := range periods {
+				if !p.EndsAt.instant.Equal(tt.ends[i]) {
+					t.Errorf("Period %d end: expected %v, got %v", i, tt.ends[i], p.EndsAt.Time())
+				}
+				if i > 0 && (p.IsPartial() || !p.StartsAt.Equal(periods[i-1].EndsAt)) {
+					t.Errorf("Period %d should be a full, contiguous cycle", i)
+				}
+			}
+			if periods[0].IsPartial() != tt.partial {
+				t.Errorf("Expected first period partial %v, got %v", tt.partial, periods[0].IsPartial())
+			}
+		})
+	}
+
+	if periods := Now(time.UTC).CyclesAligned(0, Quarterly); len(periods) != 0 {
+		t.Errorf("Expected 0 periods, got %d", len(periods))
+	}
+}
+
+func TestCyclesAnchoredWeekly(t *testing.T) {
+	// Wednesday Jan 17, 2024 10:00 UTC, anchored to Mondays
+	start := New(time.Date(2024, 1, 17, 10, 0, 0, 0, time.UTC), time.UTC)
+
+	periods := start.CyclesAnchoredWeekly(3, time.Monday)
+
+	if len(periods) != 3 {
+		t.Fatalf("Expected 3 periods, got %d", len(periods))
+	}
+
+	// First period is a stub: Wed Jan 17 10:00 -> Mon Jan 22 00:00
+	if !periods[0].StartsAt.Equal(start) {
+		t.Error("First period should start at the Zeit")
+	}
+	expectedEnd := time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC)
+	if !periods[0].EndsAt.instant.Equal(expectedEnd) {
+		t.Errorf("Stub end: expected %v, got %v", expectedEnd, periods[0].EndsAt.instant)
+	}
+
+	// Following periods run Monday to Monday
+	expectedEnd = time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)
+	if !periods[2].EndsAt.instant.Equal(expectedEnd) {
+		t.Errorf("Period 2 end: expected %v, got %v", expectedEnd, periods[2].EndsAt.instant)
+	}
+	if !periods[0].IsPartial() {
+		t.Error("Stub period should be partial")
+	}
+	for i := 1; i < len(periods); i++ {
+		if !periods[i].StartsAt.Equal(periods[i-1].EndsAt) {
+			t.Errorf("Gap/overlap between period %d and %d", i-1, i)
+		}
+		if periods[i].IsPartial() {
+			t.Errorf("Period %d should not be partial", i)
+		}
+		if periods[i].Duration() != 7*24*time.Hour {
+			t.Errorf("Period %d should be a full week, got %v", i, periods[i].Duration())
+		}
+	}
+}
+
+func TestCyclesAnchoredWeekly_OnAnchor(t *testing.T) {
+	// Monday Jan 22, 2024 at midnight: no stub period
+	start := New(time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	periods := start.CyclesAnchoredWeekly(1, time.Monday)
+
+	expectedEnd := time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC)
+	if !periods[0].EndsAt.instant.Equal(expectedEnd) {
+		t.Errorf("Expected %v, got %v", expectedEnd, periods[0].EndsAt.instant)
+	}
+	if periods[0].IsPartial() {
+		t.Error("A full first week should not be partial")
+	}
+}
+
+func TestCyclesAnchoredWeekly_LocalMidnight(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	// Friday Mar 22, 2024 in Berlin; DST starts Sunday Mar 31
+	start := New(time.Date(2024, 3, 22, 15, 0, 0, 0, berlin), berlin)
+
+	periods := start.CyclesAnchoredWeekly(3, time.Monday)
+
+	for i, p := range periods {
+		local := p.EndsAt.Time()
+		if local.Weekday() != time.Monday || local.Hour() != 0 || local.Minute() != 0 {
+			t.Errorf("Period %d should end at local Monday midnight, got %v", i, local)
+		}
+	}
+}
+
+func TestCyclesAnchoredWeekly_ZeroCount(t *testing.T) {
+	z := Now(time.UTC)
+
+	if periods := z.CyclesAnchoredWeekly(0, time.Monday); len(periods) != 0 {
+		t.Errorf("Expected 0 periods, got %d", len(periods))
+	}
+}
+
+func TestCyclesAnchoredYearly(t *testing.T) {
+	// Signup Mar 10, 2024; policy renews every Jul 1
+	start := New(time.Date(2024, 3, 10, 9, 0, 0, 0, time.UTC), time.UTC)
+
+	periods := start.CyclesAnchoredYearly(3, time.July, 1, LeapDayFeb28)
+
+	if len(periods) != 3 {
+		t.Fatalf("Expected 3 periods, got %d", len(periods))
+	}
+
+	expected := []time.Time{
+		time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for i, want := range expected {
+		if !periods[i].EndsAt.instant.Equal(want) {
+			t.Errorf("Period %d end: expected %v, got %v", i, want, periods[i].EndsAt.instant)
+		}
+	}
+	if !periods[0].StartsAt.Equal(start) {
+		t.Error("First period should start at the Zeit")
+	}
+	if !periods[0].IsPartial() || periods[1].IsPartial() || periods[2].IsPartial() {
+		t.Error("Only the stub first period should be partial")
+	}
+}
+
+func TestCyclesAnchoredYearly_PastAnchor(t *testing.T) {
+	// Anchor date already passed this year: first renewal is next year
+	start := New(time.Date(2024, 8, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	periods := start.CyclesAnchoredYearly(1, time.July, 1, LeapDayFeb28)
+
+	expected := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	if !periods[0].EndsAt.instant.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, periods[0].EndsAt.instant)
+	}
+}
+
+func TestCyclesAnchoredYearly_OnAnchor(t *testing.T) {
+	start := New(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	periods := start.CyclesAnchoredYearly(1, time.July, 1, LeapDayFeb28)
+
+	expected := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	if !periods[0].EndsAt.instant.Equal(expected) {
+		t.Errorf("Expected a full first year ending %v, got %v", expected, periods[0].EndsAt.instant)
+	}
+	if periods[0].IsPartial() {
+		t.Error("A full first year should not be partial")
+	}
+}
+
+func TestCycles_NotPartial(t *testing.T) {
+	start := New(time.Date(2024, 1, 17, 10, 0, 0, 0, time.UTC), time.UTC)
+
+	for i, p := range start.Cycles(3, Monthly) {
+		if p.IsPartial() {
+			t.Errorf("Period %d of unanchored cycles should not be partial", i)
+		}
+	}
+}
+
+func TestCyclesAnchoredYearly_LeapDay(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		name     string
+		expected time.Time
+		policy   LeapDayPolicy
+	}{
+		{"Feb 28", time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), LeapDayFeb28},
+		{"Mar 1", time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), LeapDayMar1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods := start.CyclesAnchoredYearly(2, time.February, 29, tt.policy)
+
+			leap := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+			if !periods[0].EndsAt.instant.Equal(leap) {
+				t.Errorf("Leap year renewal: expected %v, got %v", leap, periods[0].EndsAt.instant)
+			}
+			if !periods[1].EndsAt.instant.Equal(tt.expected) {
+				t.Errorf("Non-leap renewal: expected %v, got %v", tt.expected, periods[1].EndsAt.instant)
+			}
+		})
+	}
+}
+
+func TestCyclesEvery(t *testing.T) {
+	start := New(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), time.UTC)
+	span, _ := ParseInterval("every month")
+
+	periods := start.CyclesEvery(3, span)
+
+	expected := []time.Time{
+		time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+	}
+	if len(periods) != len(expected) {
+		t.Fatalf("Expected %d periods, got %d", len(expected), len(periods))
+	}
+	for i, want := range expected {
+		if !periods[i].EndsAt.instant.Equal(want) {
+			t.Errorf("Period %d end: expected %v, got %v", i, want, periods[i].EndsAt.instant)
+		}
+		if i > 0 && !periods[i].StartsAt.Equal(periods[i-1].EndsAt) {
+			t.Errorf("Gap/overlap between period %d and %d", i-1, i)
+		}
+	}
+}
+
+func TestCyclesLocal(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		start    time.Time
+		ends     []time.Time
+		name     string
+		interval BillingInterval
+	}{
+		{
+			name:     "Monthly across DST",
+			start:    time.Date(2024, 2, 1, 0, 0, 0, 0, berlin),
+			interval: Monthly,
+			ends: []time.Time{
+				time.Date(2024, 3, 1, 0, 0, 0, 0, berlin),
+				time.Date(2024, 4, 1, 0, 0, 0, 0, berlin),
+				time.Date(2024, 5, 1, 0, 0, 0, 0, berlin),
+			},
+		},
+		{
+			name:     "Weekly across DST",
+			start:    time.Date(2024, 3, 25, 0, 0, 0, 0, berlin),
+			interval: Weekly,
+			ends: []time.Time{
+				time.Date(2024, 4, 1, 0, 0, 0, 0, berlin),
+				time.Date(2024, 4, 8, 0, 0, 0, 0, berlin),
+			},
+		},
+		{
+			name:     "Month end clamps without drift",
+			start:    time.Date(2024, 1, 31, 0, 0, 0, 0, berlin),
+			interval: Monthly,
+			ends: []time.Time{
+				time.Date(2024, 2, 29, 0, 0, 0, 0, berlin),
+				time.Date(2024, 3, 31, 0, 0, 0, 0, berlin),
+				time.Date(2024, 4, 30, 0, 0, 0, 0, berlin),
+			},
+		},
+		{
+			name:     "Quarterly",
+			start:    time.Date(2024, 1, 1, 0, 0, 0, 0, berlin),
+			interval: Quarterly,
+			ends: []time.Time{
+				time.Date(2024, 4, 1, 0, 0, 0, 0, berlin),
+				time.Date(2024, 7, 1, 0, 0, 0, 0, berlin),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := New(tt.start, berlin)
+			periods := start.CyclesLocal(len(tt.ends), tt.interval)
+
+			if len(periods) != len(tt.ends) {
+				t.Fatalf("Expected %d periods, got %d", len(tt.ends), len(periods))
+			}
+			for i, p := range periods {
+				if !p.EndsAt.instant.Equal(tt.ends[i]) {
+					t.Errorf("Period %d end: expected %v, got %v", i, tt.ends[i], p.EndsAt.Time())
+				}
+				if i > 0 && !p.StartsAt.Equal(periods[i-1].EndsAt) {
+					t.Errorf("Gap/overlap between period %d and %d", i-1, i)
+				}
+			}
+		})
+	}
+}
+
+func TestCyclesLocal_CyclesDrift(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := New(time.Date(2024, 3, 15, 0, 0, 0, 0, berlin), berlin)
+
+	if got := start.Cycles(1, Monthly)[0].EndsAt.Time().Hour(); got != 1 {
+		t.Errorf("Expected Cycles to renew at 01:00 in summer, got %02d:00", got)
+	}
+	if got := start.CyclesLocal(1, Monthly)[0].EndsAt.Time().Hour(); got != 0 {
+		t.Errorf("Expected CyclesLocal to renew at 00:00, got %02d:00", got)
+	}
+	if periods := start.CyclesLocal(0, Monthly); len(periods) != 0 {
+		t.Errorf("Expected 0 periods for zero count, got %d", len(periods))
+	}
+}
+
+func TestCyclesToReach(t *testing.T) {
+	start := New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+	at := func(year, month, day int) *Zeit {
+		return New(time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), time.UTC)
+	}
+
+	tests := []struct {
+		target   *Zeit
+		name     string
+		interval BillingInterval
+		expected int
+	}{
+		{name: "Within first cycle", target: at(2024, 2, 1), interval: Monthly, expected: 1},
+		{name: "On a renewal", target: at(2024, 3, 15), interval: Monthly, expected: 2},
+		{name: "Just after a renewal", target: at(2024, 3, 16), interval: Monthly, expected: 3},
+		{name: "Two years monthly", target: at(2026, 1, 1), interval: Monthly, expected: 24},
+		{name: "Quarterly", target: at(2025, 1, 15), interval: Quarterly, expected: 4},
+		{name: "Weekly", target: at(2024, 2, 1), interval: Weekly, expected: 3},
+		{name: "Yearly", target: at(2030, 6, 1), interval: Yearly, expected: 7},
+		{name: "Target at start", target: start, interval: Monthly, expected: 0},
+		{name: "Target before start", target: at(2023, 1, 1), interval: Monthly, expected: 0},
+		{name: "Nil target", target: nil, interval: Monthly, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CyclesToReach(start, tt.interval, tt.target)
+			if got != tt.expected {
+				t.Fatalf("Expected %d, got %d", tt.expected, got)
+			}
+			if got > 0 {
+				last := start.Cycles(got, tt.interval)[got-1]
+				if !last.StartsAt.Before(tt.target) || last.EndsAt.Before(tt.target) {
+					t.Errorf("Expected the last of %d cycles to run until the target", got)
+				}
+			}
+		})
+	}
+}
+
+func TestCyclesEvery_Invalid(t *testing.T) {
+	start := Now(time.UTC)
+
+	if periods := start.CyclesEvery(0, Span(1, Months)); len(periods) != 0 {
+		t.Errorf("Expected 0 periods for zero count, got %d", len(periods))
+	}
+	if periods := start.CyclesEvery(3, Span(0, Months)); len(periods) != 0 {
+		t.Errorf("Expected 0 periods for empty span, got %d", len(periods))
+	}
+}
+
+func TestBillingInterval_Span(t *testing.T) {
+	tests := []struct {
+		interval BillingInterval
+		expected CalendarSpan
+	}{
+		{Daily, Span(1, Days)},
+		{Weekly, Span(1, Weeks)},
+		{Monthly, Span(1, Months)},
+		{Quarterly, Span(3, Months)},
+		{Yearly, Span(1, Years)},
+		{SemiAnnually, Span(6, Months)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.interval.String(), func(t *testing.T) {
+			if result := tt.interval.Span(); result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestBillingInterval_AdvanceBy(t *testing.T) {
+	starts := []time.Time{
+		time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 29, 10, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 31, 23, 30, 0, 0, time.UTC),
+		time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 8, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 11, 30, 12, 0, 0, 0, time.UTC),
+		time.Date(2095, 12, 29, 0, 0, 0, 0, time.UTC),
+	}
+	intervals := []BillingInterval{Daily, Weekly, Monthly, Quarterly, SemiAnnually, Yearly}
+
+	for _, start := range starts {
+		for _, interval := range intervals {
+			periods := New(start, time.UTC).Cycles(60, interval)
+			for n, p := range periods {
+				if got := interval.advanceBy(start, n); !got.Equal(p.StartsAt.instant) {
+					t.Errorf("%v + %d of interval %d: expected %v, got %v", start, n, interval, p.StartsAt.instant, got)
+				}
+			}
+		}
+	}
+}
+
+func TestBillingInterval_PeriodsPerYear(t *testing.T) {
+	tests := []struct {
+		interval BillingInterval
+		expected float64
+	}{
+		{Daily, 365.2425},
+		{Weekly, 52.1775},
+		{Monthly, 12},
+		{Quarterly, 4},
+		{Yearly, 1},
+		{SemiAnnually, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.interval.String(), func(t *testing.T) {
+			if result := tt.interval.PeriodsPerYear(); math.Abs(result-tt.expected) > 1e-9 {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestConvertCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     BillingInterval
+		to       BillingInterval
+		mode     RoundingMode
+		count    int
+		expected int
+	}{
+		{name: "Months to years truncated", count: 18, from: Monthly, to: Yearly, mode: RoundTruncate, expected: 1},
+		{name: "Months to years nearest", count: 18, from: Monthly, to: Yearly, mode: RoundNearest, expected: 2},
+		{name: "Months to years up", count: 13, from: Monthly, to: Yearly, mode: RoundUp, expected: 2},
+		{name: "Exact months to years", count: 24, from: Monthly, to: Yearly, mode: RoundUp, expected: 2},
+		{name: "Quarters to months", count: 3, from: Quarterly, to: Monthly, mode: RoundTruncate, expected: 9},
+		{name: "Half years to quarters", count: 3, from: SemiAnnually, to: Quarterly, mode: RoundUp, expected: 6},
+		{name: "Month to days", count: 1, from: Monthly, to: Daily, mode: RoundNearest, expected: 30},
+		{name: "Month to weeks", count: 1, from: Monthly, to: Weekly, mode: RoundNearest, expected: 4},
+		{name: "Weeks to days", count: 2, from: Weekly, to: Daily, mode: RoundTruncate, expected: 14},
+		{name: "Days to weeks", count: 14, from: Daily, to: Weekly, mode: RoundUp, expected: 2},
+		{name: "365 days to years truncated", count: 365, from: Daily, to: Yearly, mode: RoundTruncate, expected: 0},
+		{name: "365 days to years nearest", count: 365, from: Daily, to: Yearly, mode: RoundNearest, expected: 1},
+		{name: "Negative", count: -18, from: Monthly, to: Yearly, mode: RoundUp, expected: -2},
+		{name: "Zero", count: 0, from: Yearly, to: Daily, mode: RoundUp, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := ConvertCount(tt.count, tt.from, tt.to, tt.mode); result != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestConvertRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     BillingInterval
+		to       BillingInterval
+		amount   float64
+		expected float64
+	}{
+		{name: "Monthly to yearly", amount: 10, from: Monthly, to: Yearly, expected: 120},
+		{name: "Yearly to monthly", amount: 120, from: Yearly, to: Monthly, expected: 10},
+		{name: "Quarterly to semi-annually", amount: 30, from: Quarterly, to: SemiAnnually, expected: 60},
+		{name: "Weekly to yearly", amount: 10, from: Weekly, to: Yearly, expected: 521.775},
+		{name: "Daily to monthly", amount: 2, from: Daily, to: Monthly, expected: 60.87375},
+		{name: "Same interval", amount: 9.99, from: Monthly, to: Monthly, expected: 9.99},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := ConvertRate(tt.amount, tt.from, tt.to); math.Abs(result-tt.expected) > 1e-9 {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCyclesUntil(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	contractEnd := New(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		lastEnd time.Time
+		name    string
+		clip    bool
+		partial bool
+	}{
+		{
+			name:    "Clipped at contract end",
+			clip:    true,
+			lastEnd: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+			partial: true,
+		},
+		{
+			name:    "Full last cycle",
+			lastEnd: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods := start.CyclesUntil(contractEnd, Monthly, tt.clip)
+
+			if len(periods) != 3 {
+				t.Fatalf("Expected 3 periods, got %d", len(periods))
+			}
+			last := periods[2]
+			if !last.EndsAt.instant.Equal(tt.lastEnd) {
+				t.Errorf("Expected last end %v, got %v", tt.lastEnd, last.EndsAt.instant)
+			}
+			if last.IsPartial() != tt.partial {
+				t.Errorf("Expected partial %v, got %v", tt.partial, last.IsPartial())
+			}
+			if periods[0].IsPartial() || periods[1].IsPartial() {
+				t.Error("Full periods should not be partial")
+			}
+		})
+	}
+}
+
+func TestMonthsUntil(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		start    time.Time
+		end      time.Time
+		name     string
+		bounds   []time.Time
+		partials []bool
+	}{
+		{
+			name:  "Mid-month to mid-month",
+			start: time.Date(2024, 1, 15, 10, 0, 0, 0, berlin),
+			end:   time.Date(2024, 3, 10, 0, 0, 0, 0, berlin),
+			bounds: []time.Time{
+				time.Date(2024, 1, 15, 10, 0, 0, 0, berlin),
+				time.Date(2024, 2, 1, 0, 0, 0, 0, berlin),
+				time.Date(2024, 3, 1, 0, 0, 0, 0, berlin),
+				time.Date(2024, 3, 10, 0, 0, 0, 0, berlin),
+			},
+			partials: []bool{true, false, true},
+		},
+		{
+			name:  "Whole months",
+			start: time.Date(2024, 11, 1, 0, 0, 0, 0, berlin),
+			end:   time.Date(2025, 1, 1, 0, 0, 0, 0, berlin),
+			bounds: []time.Time{
+				time.Date(2024, 11, 1, 0, 0, 0, 0, berlin),
+				time.Date(2024, 12, 1, 0, 0, 0, 0, berlin),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, berlin),
+			},
+			partials: []bool{false, false},
+		},
+		{
+			name:  "Within one month",
+			start: time.Date(2024, 2, 10, 0, 0, 0, 0, berlin),
+			end:   time.Date(2024, 2, 20, 0, 0, 0, 0, berlin),
+			bounds: []time.Time{
+				time.Date(2024, 2, 10, 0, 0, 0, 0, berlin),
+				time.Date(2024, 2, 20, 0, 0, 0, 0, berlin),
+			},
+			partials: []bool{true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods := New(tt.start, berlin).MonthsUntil(New(tt.end, berlin))
+
+			if len(periods) != len(tt.partials) {
+				t.Fatalf("Expected %d periods, got %d", len(tt.partials), len(periods))
+			}
+			for i, p := range periods {
+				if !p.StartsAt.instant.Equal(tt.bounds[i]) || !p.EndsAt.instant.Equal(tt.bounds[i+1]) {
+					t.Errorf("Period %d: expected %v to %v, got %v to %v", i, tt.bounds[i], tt.bounds[i+1], p.StartsAt.Time(), p.EndsAt.Time())
+				}
+				if p.IsPartial() != tt.partials[i] {
+					t.Errorf("Period %d: expected partial %v, got %v", i, tt.partials[i], p.IsPartial())
+				}
+			}
+		})
+	}
+
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	if periods := start.MonthsUntil(start); len(periods) != 0 {
+		t.Errorf("Expected 0 periods, got %d", len(periods))
+	}
+	if periods := start.MonthsUntil(nil); len(periods) != 0 {
+		t.Errorf("Expected 0 periods for nil end, got %d", len(periods))
+	}
+}
+
+func TestCyclesUntil_AlignedEnd(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	end := New(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	periods := start.CyclesUntil(end, Monthly, true)
+
+	if len(periods) != 3 {
+		t.Fatalf("Expected 3 periods, got %d", len(periods))
+	}
+	if periods[2].IsPartial() || !periods[2].EndsAt.Equal(end) {
+		t.Error("A cycle ending exactly at end should be full")
+	}
+}
+
+func TestCyclesUntil_EndNotAfterStart(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	if periods := start.CyclesUntil(start, Monthly, true); len(periods) != 0 {
+		t.Errorf("Expected 0 periods, got %d", len(periods))
+	}
+	if periods := start.CyclesUntil(nil, Monthly, true); len(periods) != 0 {
+		t.Errorf("Expected 0 periods for nil end, got %d", len(periods))
+	}
+}
+
+func TestPeriodKind_JSON(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	end := New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		expected string
+		kind     PeriodKind
+	}{
+		{`{"StartsAt":"2024-01-01T00:00:00Z","EndsAt":"2024-01-15T00:00:00Z"}`, PeriodRegular},
+		{`{"StartsAt":"2024-01-01T00:00:00Z","EndsAt":"2024-01-15T00:00:00Z","Kind":"trial"}`, PeriodTrial},
+		{`{"StartsAt":"2024-01-01T00:00:00Z","EndsAt":"2024-01-15T00:00:00Z","Kind":"dunning"}`, PeriodDunning},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			data, err := json.Marshal(&Period{StartsAt: start, EndsAt: end, Kind: tt.kind})
+			if err != nil {
+				t.Fatalf("Marshal error: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, data)
+			}
+
+			var decoded Period
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("Unmarshal error: %v", err)
+			}
+			if decoded.Kind != tt.kind {
+				t.Errorf("Expected kind %v, got %v", tt.kind, decoded.Kind)
+			}
+		})
+	}
+}
+
+func TestPeriodKind_Invalid(t *testing.T) {
+	var p Period
+	if err := json.Unmarshal([]byte(`{"Kind":"holiday"}`), &p); err == nil {
+		t.Error("Expected error for unknown kind")
+	}
+	if _, err := json.Marshal(&Period{StartsAt: Now(time.UTC), Kind: PeriodKind(9)}); err == nil {
+		t.Error("Expected error for out-of-range kind")
+	}
+	if PeriodKind(9).String() != "PeriodKind(9)" {
+		t.Errorf("Expected PeriodKind(9), got %s", PeriodKind(9).String())
+	}
+}
+
+func TestPeriod_KindPreserved(t *testing.T) {
+	start := New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+	end := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	p := &Period{StartsAt: start, EndsAt: end, Kind: PeriodGrace}
+	if p.Normalize().Kind != PeriodGrace {
+		t.Error("Normalize should keep the kind")
+	}
+}
+
+func TestCyclesInto(t *testing.T) {
+	start := New(time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC), time.UTC)
+
+	for _, interval := range []BillingInterval{Daily, Weekly, Monthly, Quarterly, Yearly, SemiAnnually} {
+		t.Run(interval.String(), func(t *testing.T) {
+			expected := start.Cycles(5, interval)
+			buf := make([]Period, 0, 5)
+
+			result := start.CyclesInto(buf, 5, interval)
+
+			if len(result) != len(expected) {
+				t.Fatalf("Expected %d periods, got %d", len(expected), len(result))
+			}
+			for i := range expected {
+				if !result[i].Equal(expected[i]) {
+					t.Errorf("Period %d differs from Cycles", i)
+				}
+			}
+			if &result[0] != &buf[:1][0] {
+				t.Error("CyclesInto should reuse dst's backing array")
+			}
+		})
+	}
+}
+
+func TestCyclesInto_Appends(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	dst := start.CyclesInto(nil, 2, Monthly)
+	dst = dst[1].EndsAt.CyclesInto(dst, 2, Monthly)
+
+	if len(dst) != 4 {
+		t.Fatalf("Expected 4 periods, got %d", len(dst))
+	}
+	expectedEnd := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	if !dst[3].EndsAt.instant.Equal(expectedEnd) {
+		t.Errorf("Expected %v, got %v", expectedEnd, dst[3].EndsAt.instant)
+	}
+	if len(start.CyclesInto(dst[:0], 0, Monthly)) != 0 {
+		t.Error("Zero count should append nothing")
+	}
+}
+
+func BenchmarkCycles(b *testing.B) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_ = start.Cycles(120, Monthly)
+	}
+}
+
+func BenchmarkCyclesInto(b *testing.B) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	buf := make([]Period, 0, 120)
+	b.ReportAllocs()
+
+	for b.Loop() {
+		buf = start.CyclesInto(buf[:0], 120, Monthly)
+	}
+}
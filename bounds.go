@@ -0,0 +1,68 @@
+package zeit
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// validRange is the package-wide range accepted at parsing and scanning boundaries.
+// A nil bound leaves that side open.
+type validRange struct {
+	earliest *Zeit
+	latest   *Zeit
+}
+
+// currentRange holds the configured validRange; nil means unbounded.
+var currentRange atomic.Pointer[validRange]
+
+// SetValidRange configures the package-wide range of acceptable times, both bounds
+// inclusive. FromUser, Scan, UnmarshalJSON, UnmarshalGQL, ParsePeriod and the
+// checked arithmetic methods reject values outside it with ErrOutOfRange, so
+// corrupt timestamps (epoch 0, year 9999) are caught at ingestion.
+// A nil bound leaves that side open; SetValidRange(nil, nil) removes the range.
+// Returns ErrOutOfRange if earliest is after latest. Safe for concurrent use.
+func SetValidRange(earliest, latest *Zeit) error {
+	if earliest != nil && latest != nil && earliest.After(latest) {
+		return fmt.Errorf("%w: valid range starts at %s, after its end %s", ErrOutOfRange, earliest.ToUser(), latest.ToUser())
+	}
+	if earliest == nil && latest == nil {
+		currentRange.Store(nil)
+		return nil
+	}
+	currentRange.Store(&validRange{earliest: earliest, latest: latest})
+	return nil
+}
+
+// ValidRange returns the configured range bounds; nil means unbounded on that side.
+func ValidRange() (earliest, latest *Zeit) {
+	r := currentRange.Load()
+	if r == nil {
+		return nil, nil
+	}
+	return r.earliest, r.latest
+}
+
+// Validate returns ErrOutOfRange if the Zeit falls outside the configured valid range.
+func (z *Zeit) Validate() error {
+	earliest, latest := ValidRange()
+	if earliest != nil && z.Before(earliest) {
+		return fmt.Errorf("%w: %s is before %s", ErrOutOfRange, z.ToUser(), earliest.ToUser())
+	}
+	if latest != nil && z.After(latest) {
+		return fmt.Errorf("%w: %s is after %s", ErrOutOfRange, z.ToUser(), latest.ToUser())
+	}
+	return nil
+}
+
+// Clamp returns a Zeit moved into the configured valid range, keeping the Zeit's
+// timezone. Values already in range are returned unchanged.
+func (z *Zeit) Clamp() *Zeit {
+	earliest, latest := ValidRange()
+	if earliest != nil && z.Before(earliest) {
+		return earliest.In(z.location)
+	}
+	if latest != nil && z.After(latest) {
+		return latest.In(z.location)
+	}
+	return z
+}
@@ -0,0 +1,138 @@
+package zeit
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// withValidRange sets a valid range for the duration of a test.
+func withValidRange(t *testing.T, earliest, latest *Zeit) {
+	t.Helper()
+	if err := SetValidRange(earliest, latest); err != nil {
+		t.Fatalf("SetValidRange error: %v", err)
+	}
+	t.Cleanup(func() { _ = SetValidRange(nil, nil) })
+}
+
+func ingestionRange() (*Zeit, *Zeit) {
+	return New(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC),
+		New(time.Date(2500, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+}
+
+func TestSetValidRange(t *testing.T) {
+	earliest, latest := ingestionRange()
+	withValidRange(t, earliest, latest)
+
+	gotEarliest, gotLatest := ValidRange()
+	if !gotEarliest.Equal(earliest) || !gotLatest.Equal(latest) {
+		t.Error("ValidRange should return the configured bounds")
+	}
+
+	if err := SetValidRange(latest, earliest); err == nil {
+		t.Error("SetValidRange should reject a reversed range")
+	}
+	if gotEarliest, _ := ValidRange(); !gotEarliest.Equal(earliest) {
+		t.Error("A rejected range should keep the previous configuration")
+	}
+
+	_ = SetValidRange(nil, nil)
+	if gotEarliest, gotLatest := ValidRange(); gotEarliest != nil || gotLatest != nil {
+		t.Error("SetValidRange(nil, nil) should remove the range")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	earliest, latest := ingestionRange()
+
+	unconfigured := New(time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	if err := unconfigured.Validate(); err != nil {
+		t.Errorf("Without a range every time is valid, got %v", err)
+	}
+
+	withValidRange(t, earliest, latest)
+
+	tests := []struct {
+		time    time.Time
+		name    string
+		wantErr bool
+	}{
+		{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), "In range", false},
+		{time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), "Inclusive minimum", false},
+		{time.Date(2500, 1, 1, 0, 0, 0, 0, time.UTC), "Inclusive maximum", false},
+		{time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC), "Year 9999", true},
+		{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), "Zero time", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := New(tt.time, time.UTC).Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("Expected ErrOutOfRange, got %v", err)
+			}
+		})
+	}
+}
+
+func TestClamp(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	earliest, latest := ingestionRange()
+	withValidRange(t, earliest, latest)
+
+	tooLate := New(time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC), berlin)
+	clamped := tooLate.Clamp()
+	if !clamped.Equal(latest) || clamped.Location() != berlin {
+		t.Errorf("Expected %v in Berlin, got %v in %v", latest.instant, clamped.instant, clamped.Location())
+	}
+
+	tooEarly := New(time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	if !tooEarly.Clamp().Equal(earliest) {
+		t.Error("Clamp should move early times to the minimum")
+	}
+
+	inRange := New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+	if inRange.Clamp() != inRange {
+		t.Error("Clamp should return in-range values unchanged")
+	}
+}
+
+func TestValidRange_Boundaries(t *testing.T) {
+	// Epoch 0 is a classic corrupt value
+	withValidRange(t, New(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC), nil)
+
+	if _, err := FromUser("1970-01-01T00:00:00Z", time.UTC); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("FromUser: expected ErrOutOfRange, got %v", err)
+	}
+
+	var z Zeit
+	if err := z.Scan(int64(0)); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Scan: expected ErrOutOfRange, got %v", err)
+	}
+	if z.location != nil {
+		t.Error("Scan should leave the receiver untouched on error")
+	}
+
+	if err := z.UnmarshalJSON([]byte(`"1970-01-01T00:00:00Z"`)); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("UnmarshalJSON: expected ErrOutOfRange, got %v", err)
+	}
+	if err := z.UnmarshalGQL("1970-01-01T00:00:00Z"); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("UnmarshalGQL: expected ErrOutOfRange, got %v", err)
+	}
+	if _, err := ParsePeriod("1970-01-01/2024-01-01", time.UTC); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("ParsePeriod: expected ErrOutOfRange, got %v", err)
+	}
+
+	valid := New(time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC), time.UTC)
+	if _, err := valid.AddDaysChecked(-2); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("AddDaysChecked: expected ErrOutOfRange, got %v", err)
+	}
+	if _, err := valid.AddChecked(-48 * time.Hour); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("AddChecked: expected ErrOutOfRange, got %v", err)
+	}
+	if _, err := valid.AddChecked(time.Hour); err != nil {
+		t.Errorf("AddChecked within range: unexpected error %v", err)
+	}
+}
@@ -0,0 +1,293 @@
+package zeit
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// maxClosedDays is how many consecutive non-business days the business-day
+// searches scan before giving up, so an open-ended absence can't stall them.
+const maxClosedDays = 3660
+
+// Holiday is a day on which no business is conducted.
+// A zero Year marks a holiday that recurs on the same date every year.
+type Holiday struct {
+	Name  string
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// Calendar describes which days are business days: Monday to Friday, excluding holidays.
+// A nil *Calendar is a plain Monday-Friday calendar without holidays.
+// Calendars can be combined with Intersect and Union.
+type Calendar struct {
+	holidays []Holiday
+	absences []*Period
+	parts    []*Calendar
+	combine  calendarCombine
+}
+
+// calendarCombine selects how a combined Calendar evaluates its parts.
+type calendarCombine int
+
+const (
+	// combineNone marks a plain calendar with its own holidays.
+	combineNone calendarCombine = iota
+	// combineIntersect requires a business day in every part.
+	combineIntersect
+	// combineUnion requires a business day in at least one part.
+	combineUnion
+)
+
+// NewCalendar creates a Calendar with the given holidays.
+func NewCalendar(holidays ...Holiday) *Calendar {
+	return &Calendar{holidays: slices.Clone(holidays)}
+}
+
+// Intersect returns a Calendar whose business days are business days in both c and other.
+// Useful for cross-border settlement, e.g. days that are open in New York and London.
+func (c *Calendar) Intersect(other *Calendar) *Calendar {
+	return &Calendar{parts: []*Calendar{c, other}, combine: combineIntersect}
+}
+
+// Union returns a Calendar whose business days are business days in c or other.
+func (c *Calendar) Union(other *Calendar) *Calendar {
+	return &Calendar{parts: []*Calendar{c, other}, combine: combineUnion}
+}
+
+// WithAbsences returns a copy of the calendar with absence periods, e.g. an employee's
+// vacation. Business-day math skips days fully covered by an absence; business-hour
+// math subtracts the exact absence time, so partial-day absences only affect hours.
+func (c *Calendar) WithAbsences(periods ...*Period) *Calendar {
+	var cp Calendar
+	if c != nil {
+		cp = *c
+	}
+	cp.absences = append(slices.Clone(cp.absences), periods...)
+	return &cp
+}
+
+// AddBusinessDays returns a new Zeit with business days added according to the calendar.
+// Days are counted on the Zeit's local calendar and the local time of day is kept.
+// Negative values count backwards. Zero returns the Zeit unchanged, even on a non-business day.
+// Returns ErrNoBusinessDay if no business day follows within ten years, as behind
+// an open-ended absence.
+func (c *Calendar) AddBusinessDays(z *Zeit, days int) (*Zeit, error) {
+	current := z.Time()
+	direction := 1
+	if days < 0 {
+		direction = -1
+		days = -days
+	}
+
+	for range days {
+		next, err := c.seekBusinessDay(current.AddDate(0, 0, direction), direction)
+		if err != nil {
+			return nil, err
+		}
+		current = next
+	}
+
+	return New(current, z.location), nil
+}
+
+// IsBusinessDay reports whether the Zeit's local date is a business day in cal:
+// Monday to Friday, not a holiday and not fully covered by an absence.
+// A nil cal checks for weekends only.
+func (z *Zeit) IsBusinessDay(cal *Calendar) bool {
+	return cal.isBusinessDay(z.Time())
+}
+
+// IsHoliday reports whether the Zeit's local date is a holiday in cal, on any
+// day of the week. A nil cal has no holidays.
+func (z *Zeit) IsHoliday(cal *Calendar) bool {
+	return cal.isHoliday(z.Time())
+}
+
+// IsWeekend reports whether the Zeit's local date is a Saturday or Sunday.
+func (z *Zeit) IsWeekend() bool {
+	return Weekend.Contains(z.Time().Weekday())
+}
+
+// HolidayPeriods returns each holiday in year as a full-day Period in loc,
+// from local midnight to the next local midnight, sorted by date.
+func (c *Calendar) HolidayPeriods(year int, loc *time.Location) []*Period {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	dates := c.holidayDates(year)
+	periods := make([]*Period, len(dates))
+	for i, d := range dates {
+		periods[i] = &Period{
+			StartsAt: New(time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc), loc),
+			EndsAt:   New(time.Date(d.Year(), d.Month(), d.Day()+1, 0, 0, 0, 0, loc), loc),
+		}
+	}
+	return periods
+}
+
+// holidayDates returns the distinct holiday dates in year as UTC midnights, sorted.
+// Recurring Feb 29 holidays are skipped in non-leap years. For combined calendars
+// these are the dates isHoliday reports for the combination.
+func (c *Calendar) holidayDates(year int) []time.Time {
+	if c == nil {
+		return nil
+	}
+
+	var dates []time.Time
+	for _, part := range c.parts {
+		for _, d := range part.holidayDates(year) {
+			if c.isHoliday(d) {
+				dates = append(dates, d)
+			}
+		}
+	}
+	for _, h := range c.holidays {
+		if h.Year != 0 && h.Year != year {
+			continue
+		}
+		if h.Day < 1 || h.Day > daysIn(year, h.Month) {
+			continue
+		}
+		dates = append(dates, time.Date(year, h.Month, h.Day, 0, 0, 0, 0, time.UTC))
+	}
+
+	slices.SortFunc(dates, func(a, b time.Time) int { return a.Compare(b) })
+	return slices.CompactFunc(dates, func(a, b time.Time) bool { return a.Equal(b) })
+}
+
+// isHoliday reports whether the calendar date of t is a holiday.
+// A combined Intersect calendar observes the holidays of every part,
+// a Union calendar only those shared by all parts.
+func (c *Calendar) isHoliday(t time.Time) bool {
+	if c == nil {
+		return false
+	}
+
+	switch c.combine {
+	case combineIntersect:
+		return slices.ContainsFunc(c.parts, func(p *Calendar) bool { return p.isHoliday(t) })
+	case combineUnion:
+		return !slices.ContainsFunc(c.parts, func(p *Calendar) bool { return !p.isHoliday(t) })
+	}
+
+	year, month, day := t.Date()
+	for _, h := range c.holidays {
+		if (h.Year == 0 || h.Year == year) && h.Month == month && h.Day == day {
+			return true
+		}
+	}
+	return false
+}
+
+// isBusinessDay reports whether the calendar date of t is a business day.
+func (c *Calendar) isBusinessDay(t time.Time) bool {
+	if c != nil {
+		if c.isAbsent(t) {
+			return false
+		}
+		switch c.combine {
+		case combineIntersect:
+			return !slices.ContainsFunc(c.parts, func(p *Calendar) bool { return !p.isBusinessDay(t) })
+		case combineUnion:
+			return slices.ContainsFunc(c.parts, func(p *Calendar) bool { return p.isBusinessDay(t) })
+		}
+	}
+
+	weekday := t.Weekday()
+	if weekday == time.Saturday || weekday == time.Sunday {
+		return false
+	}
+	return !c.isHoliday(t)
+}
+
+// isAbsent reports whether an absence covers the whole calendar day of t in t's location.
+func (c *Calendar) isAbsent(t time.Time) bool {
+	dayStart := New(startOfDay(t), t.Location())
+	dayEnd := New(startOfDay(t).AddDate(0, 0, 1), t.Location())
+
+	for _, a := range c.absences {
+		if !a.StartsAt.After(dayStart) && (a.EndsAt == nil || !a.EndsAt.Before(dayEnd)) {
+			return true
+		}
+	}
+	return false
+}
+
+// allAbsences returns the absences that apply to c: its own and, for an
+// Intersect calendar, those of every part.
+func (c *Calendar) allAbsences() []*Period {
+	if c == nil {
+		return nil
+	}
+
+	absences := c.absences
+	if c.combine == combineIntersect {
+		for _, part := range c.parts {
+			absences = append(slices.Clip(absences), part.allAbsences()...)
+		}
+	}
+	return absences
+}
+
+// previousBusinessDay returns t, or the closest earlier day keeping t's time of day,
+// that is a business day.
+func (c *Calendar) previousBusinessDay(t time.Time) (time.Time, error) {
+	return c.seekBusinessDay(t, -1)
+}
+
+// nextBusinessDay returns t, or the closest later day keeping t's time of day,
+// that is a business day.
+func (c *Calendar) nextBusinessDay(t time.Time) (time.Time, error) {
+	return c.seekBusinessDay(t, 1)
+}
+
+// seekBusinessDay steps from t one day at a time in direction until it reaches a
+// business day. Returns ErrNoBusinessDay after maxClosedDays days without one.
+func (c *Calendar) seekBusinessDay(t time.Time, direction int) (time.Time, error) {
+	day := t
+	for range maxClosedDays {
+		if c.isBusinessDay(day) {
+			return day, nil
+		}
+		day = day.AddDate(0, 0, direction)
+	}
+	return time.Time{}, fmt.Errorf("%w: none within %d days of %s", ErrNoBusinessDay, maxClosedDays, t.Format(time.DateOnly))
+}
+
+// closureDates returns, as sorted UTC midnights, every date in [from, to) on which
+// c or any of its parts has a holiday or an absence. Only these dates can be
+// weekdays that are not business days, which lets counts skip all other days.
+func (c *Calendar) closureDates(from, to time.Time) []time.Time {
+	if c == nil {
+		return nil
+	}
+
+	var dates []time.Time
+	for year := from.Year(); year <= to.Year(); year++ {
+		for _, h := range c.holidays {
+			if (h.Year == 0 || h.Year == year) && h.Day >= 1 && h.Day <= daysIn(year, h.Month) {
+				dates = append(dates, time.Date(year, h.Month, h.Day, 0, 0, 0, 0, time.UTC))
+			}
+		}
+	}
+	for _, a := range c.absences {
+		day := civilDate(a.StartsAt.instant)
+		if day.Before(from) {
+			day = from
+		}
+		for ; day.Before(to) && (a.EndsAt == nil || day.Before(a.EndsAt.instant)); day = day.AddDate(0, 0, 1) {
+			dates = append(dates, day)
+		}
+	}
+	for _, part := range c.parts {
+		dates = append(dates, part.closureDates(from, to)...)
+	}
+
+	dates = slices.DeleteFunc(dates, func(d time.Time) bool { return d.Before(from) || !d.Before(to) })
+	slices.SortFunc(dates, func(a, b time.Time) int { return a.Compare(b) })
+	return slices.CompactFunc(dates, func(a, b time.Time) bool { return a.Equal(b) })
+}
@@ -0,0 +1,375 @@
+package zeit
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCalendar_HolidayPeriods(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	cal := NewCalendar(
+		Holiday{Name: "Christmas Day", Month: time.December, Day: 25},
+		Holiday{Name: "New Year's Day", Month: time.January, Day: 1},
+		Holiday{Name: "Company Offsite", Year: 2024, Month: time.March, Day: 15},
+		Holiday{Name: "Duplicate", Month: time.January, Day: 1},
+	)
+
+	periods := cal.HolidayPeriods(2024, berlin)
+
+	if len(periods) != 3 {
+		t.Fatalf("Expected 3 holiday periods, got %d", len(periods))
+	}
+
+	expected := []time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, berlin),
+		time.Date(2024, 3, 15, 0, 0, 0, 0, berlin),
+		time.Date(2024, 12, 25, 0, 0, 0, 0, berlin),
+	}
+	for i, want := range expected {
+		if !periods[i].StartsAt.Time().Equal(want) {
+			t.Errorf("Holiday %d start: expected %v, got %v", i, want, periods[i].StartsAt.Time())
+		}
+		if periods[i].Duration() != 24*time.Hour {
+			t.Errorf("Holiday %d should span a full day, got %v", i, periods[i].Duration())
+		}
+		if periods[i].StartsAt.Location() != berlin {
+			t.Errorf("Holiday %d should be in the given location", i)
+		}
+	}
+
+	// One-off holidays only apply to their year
+	if got := len(cal.HolidayPeriods(2025, berlin)); got != 2 {
+		t.Errorf("Expected 2 holidays in 2025, got %d", got)
+	}
+}
+
+func TestCalendar_HolidayPeriods_DST(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	cal := NewCalendar(Holiday{Name: "DST switch", Month: time.March, Day: 31})
+
+	periods := cal.HolidayPeriods(2024, berlin)
+
+	// Mar 31, 2024 is 23 hours long in Berlin
+	if periods[0].Duration() != 23*time.Hour {
+		t.Errorf("Expected a 23 hour day, got %v", periods[0].Duration())
+	}
+}
+
+func TestCalendar_HolidayPeriods_LeapDay(t *testing.T) {
+	cal := NewCalendar(Holiday{Name: "Leap Day", Month: time.February, Day: 29})
+
+	if got := len(cal.HolidayPeriods(2024, time.UTC)); got != 1 {
+		t.Errorf("Expected leap day holiday in 2024, got %d", got)
+	}
+	if got := len(cal.HolidayPeriods(2023, time.UTC)); got != 0 {
+		t.Errorf("Expected no leap day holiday in 2023, got %d", got)
+	}
+}
+
+func TestCalendar_HolidayPeriods_Overlap(t *testing.T) {
+	// SLA window Dec 24 12:00 -> Dec 26 12:00 loses the whole of Dec 25
+	cal := NewCalendar(Holiday{Name: "Christmas Day", Month: time.December, Day: 25})
+	sla := &Period{
+		StartsAt: New(time.Date(2024, 12, 24, 12, 0, 0, 0, time.UTC), time.UTC),
+		EndsAt:   New(time.Date(2024, 12, 26, 12, 0, 0, 0, time.UTC), time.UTC),
+	}
+
+	excluded := time.Duration(0)
+	for _, h := range cal.HolidayPeriods(2024, time.UTC) {
+		if o := sla.Overlap(h); o != nil {
+			excluded += o.Duration()
+		}
+	}
+
+	if excluded != 24*time.Hour {
+		t.Errorf("Expected 24h of holiday time, got %v", excluded)
+	}
+}
+
+func TestCalendar_HolidayPeriods_Nil(t *testing.T) {
+	var cal *Calendar
+
+	if got := len(cal.HolidayPeriods(2024, time.UTC)); got != 0 {
+		t.Errorf("Nil calendar should have no holidays, got %d", got)
+	}
+}
+
+func settlementCalendars() (*Calendar, *Calendar) {
+	newYork := NewCalendar(
+		Holiday{Name: "Independence Day", Year: 2024, Month: time.July, Day: 4},
+		Holiday{Name: "Christmas Day", Month: time.December, Day: 25},
+	)
+	london := NewCalendar(
+		Holiday{Name: "Summer Bank Holiday", Year: 2024, Month: time.August, Day: 26},
+		Holiday{Name: "Christmas Day", Month: time.December, Day: 25},
+		Holiday{Name: "Boxing Day", Month: time.December, Day: 26},
+	)
+	return newYork, london
+}
+
+func TestCalendar_AddBusinessDays(t *testing.T) {
+	newYork, london := settlementCalendars()
+	// Trade on Wednesday Jul 3, 2024
+	trade := New(time.Date(2024, 7, 3, 15, 0, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		cal      *Calendar
+		expected time.Time
+		name     string
+	}{
+		{nil, time.Date(2024, 7, 5, 15, 0, 0, 0, time.UTC), "Plain weekdays"},
+		{london, time.Date(2024, 7, 5, 15, 0, 0, 0, time.UTC), "London"},
+		{newYork, time.Date(2024, 7, 8, 15, 0, 0, 0, time.UTC), "New York skips Jul 4"},
+		{newYork.Intersect(london), time.Date(2024, 7, 8, 15, 0, 0, 0, time.UTC), "Both markets open"},
+		{newYork.Union(london), time.Date(2024, 7, 5, 15, 0, 0, 0, time.UTC), "Either market open"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settlement, err := tt.cal.AddBusinessDays(trade, 2)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !settlement.instant.Equal(tt.expected) {
+				t.Errorf("T+2: expected %v, got %v", tt.expected, settlement.instant)
+			}
+		})
+	}
+}
+
+func TestCalendar_AddBusinessDays_Backwards(t *testing.T) {
+	_, london := settlementCalendars()
+	// Friday Dec 27, 2024 minus 2 business days skips Boxing Day and Christmas
+	z := New(time.Date(2024, 12, 27, 9, 0, 0, 0, time.UTC), time.UTC)
+
+	result, err := london.AddBusinessDays(z, -2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := time.Date(2024, 12, 23, 9, 0, 0, 0, time.UTC)
+	if !result.instant.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, result.instant)
+	}
+}
+
+func TestCalendar_AddBusinessDays_LocalDate(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	// Friday 08:00 in Tokyo is still Thursday in UTC
+	z := New(time.Date(2024, 7, 5, 8, 0, 0, 0, tokyo), tokyo)
+
+	result, err := NewCalendar().AddBusinessDays(z, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := time.Date(2024, 7, 8, 8, 0, 0, 0, tokyo)
+	if !result.Time().Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, result.Time())
+	}
+	if result.Location() != tokyo {
+		t.Error("AddBusinessDays should preserve timezone")
+	}
+}
+
+func TestZeit_BusinessDayPredicates(t *testing.T) {
+	newYork, london := settlementCalendars()
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	tests := []struct {
+		cal      *Calendar
+		at       *Zeit
+		name     string
+		business bool
+		holiday  bool
+		weekend  bool
+	}{
+		{
+			name:     "Plain weekday",
+			at:       New(time.Date(2024, 7, 3, 15, 0, 0, 0, time.UTC), time.UTC),
+			cal:      newYork,
+			business: true,
+		},
+		{
+			name:    "Holiday on a weekday",
+			at:      New(time.Date(2024, 7, 4, 15, 0, 0, 0, time.UTC), time.UTC),
+			cal:     newYork,
+			holiday: true,
+		},
+		{
+			name:     "Other calendar's holiday",
+			at:       New(time.Date(2024, 7, 4, 15, 0, 0, 0, time.UTC), time.UTC),
+			cal:      london,
+			business: true,
+		},
+		{
+			name:    "Holiday on a weekend",
+			at:      New(time.Date(2022, 12, 25, 12, 0, 0, 0, time.UTC), time.UTC),
+			cal:     london,
+			holiday: true,
+			weekend: true,
+		},
+		{
+			name:    "Saturday without calendar",
+			at:      New(time.Date(2024, 7, 6, 12, 0, 0, 0, time.UTC), time.UTC),
+			weekend: true,
+		},
+		{
+			name:    "Combined calendar",
+			at:      New(time.Date(2024, 8, 26, 12, 0, 0, 0, time.UTC), time.UTC),
+			cal:     newYork.Intersect(london),
+			holiday: true,
+		},
+		{
+			name:    "Local date decides",
+			at:      New(time.Date(2024, 7, 5, 20, 0, 0, 0, time.UTC), tokyo), // Saturday in Tokyo
+			weekend: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.at.IsBusinessDay(tt.cal); got != tt.business {
+				t.Errorf("Expected IsBusinessDay %v, got %v", tt.business, got)
+			}
+			if got := tt.at.IsHoliday(tt.cal); got != tt.holiday {
+				t.Errorf("Expected IsHoliday %v, got %v", tt.holiday, got)
+			}
+			if got := tt.at.IsWeekend(); got != tt.weekend {
+				t.Errorf("Expected IsWeekend %v, got %v", tt.weekend, got)
+			}
+		})
+	}
+}
+
+func TestZeit_IsBusinessDay_Absence(t *testing.T) {
+	vacation := &Period{
+		StartsAt: New(time.Date(2024, 7, 8, 0, 0, 0, 0, time.UTC), time.UTC),
+		EndsAt:   New(time.Date(2024, 7, 13, 0, 0, 0, 0, time.UTC), time.UTC),
+	}
+	cal := NewCalendar().WithAbsences(vacation)
+	z := New(time.Date(2024, 7, 10, 9, 0, 0, 0, time.UTC), time.UTC)
+
+	if z.IsBusinessDay(cal) {
+		t.Error("A day covered by an absence should not be a business day")
+	}
+	if z.IsHoliday(cal) {
+		t.Error("An absence is not a holiday")
+	}
+}
+
+func TestCalendar_IntersectUnion_HolidayPeriods(t *testing.T) {
+	newYork, london := settlementCalendars()
+
+	both := newYork.Intersect(london).HolidayPeriods(2024, time.UTC)
+	if len(both) != 4 {
+		t.Errorf("Intersect should observe 4 holidays, got %d", len(both))
+	}
+
+	either := newYork.Union(london).HolidayPeriods(2024, time.UTC)
+	if len(either) != 1 {
+		t.Fatalf("Union should observe 1 shared holiday, got %d", len(either))
+	}
+	expected := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+	if !either[0].StartsAt.instant.Equal(expected) {
+		t.Errorf("Expected shared holiday %v, got %v", expected, either[0].StartsAt.instant)
+	}
+}
+
+func TestCalendar_WithAbsences_BusinessDays(t *testing.T) {
+	// Vacation Mon Jan 15 - Wed Jan 17, 2024, plus a half day on Thu Jan 18
+	vacation := &Period{
+		StartsAt: New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC),
+		EndsAt:   New(time.Date(2024, 1, 18, 0, 0, 0, 0, time.UTC), time.UTC),
+	}
+	halfDay := &Period{
+		StartsAt: New(time.Date(2024, 1, 18, 12, 0, 0, 0, time.UTC), time.UTC),
+		EndsAt:   New(time.Date(2024, 1, 19, 0, 0, 0, 0, time.UTC), time.UTC),
+	}
+	base := NewCalendar()
+	cal := base.WithAbsences(vacation, halfDay)
+
+	// Task assigned Friday Jan 12, due in 2 business days
+	assigned := New(time.Date(2024, 1, 12, 10, 0, 0, 0, time.UTC), time.UTC)
+	due, err := cal.AddBusinessDays(assigned, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := time.Date(2024, 1, 19, 10, 0, 0, 0, time.UTC)
+	if !due.instant.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, due.instant)
+	}
+
+	if got, _ := base.AddBusinessDays(assigned, 2); !got.instant.Equal(time.Date(2024, 1, 16, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("WithAbsences should not modify the original calendar, got %v", got.instant)
+	}
+}
+
+func TestCalendar_WithAbsences_Combined(t *testing.T) {
+	newYork, london := settlementCalendars()
+	absence := &Period{
+		StartsAt: New(time.Date(2024, 7, 5, 0, 0, 0, 0, time.UTC), time.UTC),
+		EndsAt:   New(time.Date(2024, 7, 6, 0, 0, 0, 0, time.UTC), time.UTC),
+	}
+	cal := newYork.Intersect(london).WithAbsences(absence)
+
+	// Jul 4 is a NY holiday and Jul 5 is covered by the absence
+	trade := New(time.Date(2024, 7, 3, 15, 0, 0, 0, time.UTC), time.UTC)
+	expected := time.Date(2024, 7, 9, 15, 0, 0, 0, time.UTC)
+	got, err := cal.AddBusinessDays(trade, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !got.instant.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, got.instant)
+	}
+}
+
+func TestCalendar_WithAbsences_Nil(t *testing.T) {
+	var cal *Calendar
+	absence := &Period{StartsAt: New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC)}
+
+	// Open-ended absence from Monday on: no business days remain
+	withAbsence := cal.WithAbsences(absence)
+	friday := New(time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC), time.UTC)
+	if withAbsence.isBusinessDay(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Open-ended absence should cover later days")
+	}
+	if got, err := withAbsence.AddBusinessDays(friday, -1); err != nil || !got.instant.Equal(time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Days before the absence should be unaffected, got %v, %v", got, err)
+	}
+}
+
+func TestCalendar_WithAbsences_OpenEnded(t *testing.T) {
+	start := New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+	cal := NewCalendar().WithAbsences(&Period{StartsAt: start})
+	// Backward searches find days before the absence unless it began too long ago
+	later := New(time.Date(2044, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+	payroll := NewPayroll(SemiMonthly, later, cal)
+	period := &Period{StartsAt: later, EndsAt: later.AddDays(30)}
+
+	done := make(chan []error)
+	go func() {
+		var errs []error
+		errs = append(errs, errOf(cal.AddBusinessDays(start.AddDays(3), 1)))
+		errs = append(errs, errOf(RetrySchedule(start, []time.Duration{time.Hour}, cal, true)))
+		errs = append(errs, errOf(RetryScheduleDays(start, []int{1}, cal, true)))
+		errs = append(errs, errOf(period.Reminder(time.Hour, cal, true)))
+		errs = append(errs, errOf(period.ReminderDays(1, cal, true)))
+		errs = append(errs, errOf(payroll.Periods(1)))
+		done <- errs
+	}()
+
+	select {
+	case errs := <-done:
+		for i, err := range errs {
+			if !errors.Is(err, ErrNoBusinessDay) {
+				t.Errorf("Call %d: expected ErrNoBusinessDay, got %v", i, err)
+			}
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Business-day search did not stop at the open-ended absence")
+	}
+}
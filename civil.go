@@ -0,0 +1,194 @@
+package zeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateJSON is a calendar date without time or timezone, marshaled as "2024-01-15".
+// Use it for API fields that carry only a date, such as a birthday or due date.
+type DateJSON struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// TimeJSON is a wall-clock time without date or timezone, marshaled as "10:30:00".
+// Fractional seconds are included only when Nanosecond is non-zero.
+type TimeJSON struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// DateTimeJSON is a calendar date and wall-clock time without timezone, marshaled
+// as "2024-01-15T10:30:00".
+//
+// DateJSON, TimeJSON and DateTimeJSON have the same fields as the civil types of
+// cloud database clients (BigQuery, Spanner, Datastore), so dates and times convert
+// with a plain type conversion: civil.Date(z.CivilDate()) and zeit.DateJSON(d).
+// DateTimeJSON converts field by field:
+//
+//	civil.DateTime{Date: civil.Date(dt.Date), Time: civil.Time(dt.Time)}
+type DateTimeJSON struct {
+	Date DateJSON
+	Time TimeJSON
+}
+
+// CivilDate returns the Zeit's local calendar date.
+func (z *Zeit) CivilDate() DateJSON {
+	year, month, day := z.Time().Date()
+	return DateJSON{Year: year, Month: month, Day: day}
+}
+
+// CivilTime returns the Zeit's local wall-clock time.
+func (z *Zeit) CivilTime() TimeJSON {
+	t := z.Time()
+	return TimeJSON{Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(), Nanosecond: t.Nanosecond()}
+}
+
+// CivilDateTime returns the Zeit's local date and wall-clock time.
+func (z *Zeit) CivilDateTime() DateTimeJSON {
+	return DateTimeJSON{Date: z.CivilDate(), Time: z.CivilTime()}
+}
+
+// FromCivil creates a Zeit from a local date and time in loc, such as a DATETIME
+// column read by a cloud database client. Out-of-range fields are normalized and
+// times around DST changes resolve like Date. A nil loc defaults to UTC.
+func FromCivil(dt DateTimeJSON, loc *time.Location) *Zeit {
+	return Date(dt.Date.Year, dt.Date.Month, dt.Date.Day, dt.Time.Hour, dt.Time.Minute, dt.Time.Second, dt.Time.Nanosecond, loc)
+}
+
+// String formats the date as "2006-01-02".
+func (d DateJSON) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d DateJSON) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the date unchanged.
+func (d *DateJSON) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed, err := parseDate(s)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// parseDate parses a date in the "2006-01-02" form.
+func parseDate(s string) (DateJSON, error) {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return DateJSON{}, fmt.Errorf("%w: date %q", ErrInvalidFormat, s)
+	}
+
+	year, month, day := t.Date()
+	return DateJSON{Year: year, Month: month, Day: day}, nil
+}
+
+// String formats the time as "15:04:05", with up to nine fractional digits
+// when Nanosecond is non-zero.
+func (t TimeJSON) String() string {
+	s := fmt.Sprintf("%02d:%02d:%02d", t.Hour, t.Minute, t.Second)
+	if t.Nanosecond != 0 {
+		s += strings.TrimRight(fmt.Sprintf(".%09d", t.Nanosecond), "0")
+	}
+	return s
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t TimeJSON) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Accepts "15:04:05" with optional
+// fractional seconds, or "15:04". A JSON null leaves the time unchanged.
+func (t *TimeJSON) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed, err := parseTimeOfDay(s)
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+	return nil
+}
+
+// parseTimeOfDay parses a time in the "15:04:05" form with optional fractional
+// seconds, or "15:04".
+func parseTimeOfDay(s string) (TimeJSON, error) {
+	parsed, err := time.Parse("15:04:05.999999999", s)
+	if err != nil {
+		parsed, err = time.Parse("15:04", s)
+	}
+	if err != nil {
+		return TimeJSON{}, fmt.Errorf("%w: time of day %q", ErrInvalidFormat, s)
+	}
+
+	hour, minute, sec := parsed.Clock()
+	return TimeJSON{Hour: hour, Minute: minute, Second: sec, Nanosecond: parsed.Nanosecond()}, nil
+}
+
+// String formats the date and time as "2006-01-02T15:04:05", with fractional
+// seconds as in TimeJSON.
+func (dt DateTimeJSON) String() string {
+	return dt.Date.String() + "T" + dt.Time.String()
+}
+
+// MarshalJSON implements json.Marshaler.
+func (dt DateTimeJSON) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dt.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Accepts a date and a time as for
+// DateJSON and TimeJSON, separated by "T" or a space. A JSON null leaves the
+// value unchanged.
+func (dt *DateTimeJSON) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	date, clock, ok := strings.Cut(s, "T")
+	if !ok {
+		date, clock, _ = strings.Cut(s, " ")
+	}
+
+	d, dateErr := parseDate(date)
+	t, timeErr := parseTimeOfDay(clock)
+	if dateErr != nil || timeErr != nil {
+		return fmt.Errorf("%w: date and time %q", ErrInvalidFormat, s)
+	}
+
+	*dt = DateTimeJSON{Date: d, Time: t}
+	return nil
+}
@@ -0,0 +1,227 @@
+package zeit
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDateJSON(t *testing.T) {
+	type appointment struct {
+		Date DateJSON `json:"date"`
+		Time TimeJSON `json:"time"`
+	}
+
+	in := appointment{
+		Date: DateJSON{Year: 2024, Month: time.January, Day: 15},
+		Time: TimeJSON{Hour: 10, Minute: 30},
+	}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	expected := `{"date":"2024-01-15","time":"10:30:00"}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	var out appointment
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if out != in {
+		t.Errorf("Expected %+v, got %+v", in, out)
+	}
+}
+
+func TestDateJSON_Unmarshal(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected DateJSON
+		wantErr  bool
+	}{
+		{input: `"2024-02-29"`, expected: DateJSON{Year: 2024, Month: time.February, Day: 29}},
+		{input: `null`},
+		{input: `"2023-02-29"`, wantErr: true},
+		{input: `"2024-01-15T10:30:00Z"`, wantErr: true},
+		{input: `20240115`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var d DateJSON
+			err := json.Unmarshal([]byte(tt.input), &d)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && d != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, d)
+			}
+		})
+	}
+}
+
+func TestTimeJSON_Unmarshal(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected TimeJSON
+		wantErr  bool
+	}{
+		{input: `"10:30:00"`, expected: TimeJSON{Hour: 10, Minute: 30}},
+		{input: `"23:59:59.5"`, expected: TimeJSON{Hour: 23, Minute: 59, Second: 59, Nanosecond: 500000000}},
+		{input: `"08:15"`, expected: TimeJSON{Hour: 8, Minute: 15}},
+		{input: `null`},
+		{input: `"24:00:00"`, wantErr: true},
+		{input: `"10:30 PM"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var tm TimeJSON
+			err := json.Unmarshal([]byte(tt.input), &tm)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && tm != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, tm)
+			}
+		})
+	}
+}
+
+func TestTimeJSON_String(t *testing.T) {
+	tm := TimeJSON{Hour: 9, Minute: 5, Second: 7, Nanosecond: 120000000}
+
+	if result := tm.String(); result != "09:05:07.12" {
+		t.Errorf("Expected 09:05:07.12, got %s", result)
+	}
+}
+
+func TestCivil_FromZeit(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := New(time.Date(2024, 1, 15, 23, 30, 5, 250000000, time.UTC), berlin)
+
+	dt := z.CivilDateTime()
+	expected := DateTimeJSON{
+		Date: DateJSON{Year: 2024, Month: time.January, Day: 16},
+		Time: TimeJSON{Hour: 0, Minute: 30, Second: 5, Nanosecond: 250000000},
+	}
+	if dt != expected {
+		t.Errorf("Expected %+v, got %+v", expected, dt)
+	}
+	if z.CivilDate() != expected.Date || z.CivilTime() != expected.Time {
+		t.Errorf("Expected %v and %v, got %v and %v", expected.Date, expected.Time, z.CivilDate(), z.CivilTime())
+	}
+}
+
+func TestFromCivil(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		loc      *time.Location
+		expected time.Time
+		name     string
+		dt       DateTimeJSON
+	}{
+		{
+			name:     "Local wall clock",
+			dt:       DateTimeJSON{Date: DateJSON{2024, time.January, 15}, Time: TimeJSON{Hour: 10, Minute: 30}},
+			loc:      berlin,
+			expected: time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "Date only",
+			dt:       DateTimeJSON{Date: DateJSON{2024, time.July, 1}},
+			loc:      berlin,
+			expected: time.Date(2024, 6, 30, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Skipped by DST",
+			dt:       DateTimeJSON{Date: DateJSON{2024, time.March, 31}, Time: TimeJSON{Hour: 2, Minute: 30}},
+			loc:      berlin,
+			expected: time.Date(2024, 3, 31, 1, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "Nil location",
+			dt:       DateTimeJSON{Date: DateJSON{2024, time.January, 15}, Time: TimeJSON{Nanosecond: 5}},
+			expected: time.Date(2024, 1, 15, 0, 0, 0, 5, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := FromCivil(tt.dt, tt.loc)
+			if !z.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, z.instant)
+			}
+		})
+	}
+}
+
+func TestCivil_TypeConversion(t *testing.T) {
+	// Shapes of cloud.google.com/go/civil.Date and civil.Time.
+	type civilDate struct {
+		Year  int
+		Month time.Month
+		Day   int
+	}
+	type civilTime struct {
+		Hour       int
+		Minute     int
+		Second     int
+		Nanosecond int
+	}
+
+	z := New(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), time.UTC)
+	d := civilDate(z.CivilDate())
+	c := civilTime(z.CivilTime())
+	if d != (civilDate{2024, time.January, 15}) || c != (civilTime{Hour: 10, Minute: 30}) {
+		t.Errorf("Expected 2024-01-15 10:30, got %+v %+v", d, c)
+	}
+
+	back := FromCivil(DateTimeJSON{Date: DateJSON(d), Time: TimeJSON(c)}, time.UTC)
+	if !back.Equal(z) {
+		t.Errorf("Expected %v, got %v", z, back)
+	}
+}
+
+func TestDateTimeJSON(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected DateTimeJSON
+		wantErr  bool
+	}{
+		{input: `"2024-01-15T10:30:00"`, expected: DateTimeJSON{DateJSON{2024, time.January, 15}, TimeJSON{Hour: 10, Minute: 30}}},
+		{input: `"2024-01-15 10:30:00.5"`, expected: DateTimeJSON{DateJSON{2024, time.January, 15}, TimeJSON{Hour: 10, Minute: 30, Nanosecond: 500000000}}},
+		{input: `"2024-01-15T10:30"`, expected: DateTimeJSON{DateJSON{2024, time.January, 15}, TimeJSON{Hour: 10, Minute: 30}}},
+		{input: `"2024-01-15"`, wantErr: true},
+		{input: `"2024-02-30T10:30:00"`, wantErr: true},
+		{input: `"2024-01-15T10:30:00Z"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var dt DateTimeJSON
+			err := json.Unmarshal([]byte(tt.input), &dt)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidFormat) {
+					t.Errorf("Expected %v, got %v", ErrInvalidFormat, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if dt != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, dt)
+			}
+		})
+	}
+
+	data, err := json.Marshal(DateTimeJSON{DateJSON{2024, time.January, 15}, TimeJSON{Hour: 10, Minute: 30, Nanosecond: 500000000}})
+	if err != nil || string(data) != `"2024-01-15T10:30:00.5"` {
+		t.Errorf("Expected \"2024-01-15T10:30:00.5\", got %s (%v)", data, err)
+	}
+}
@@ -0,0 +1,35 @@
+package zeit
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// OffsetProvider returns the correction to add to the system clock, such as an
+// offset measured by chrony/NTP or a time sync handshake with a server.
+// A positive offset means the system clock is behind.
+type OffsetProvider func() time.Duration
+
+// currentOffset holds the registered OffsetProvider; nil means no correction.
+var currentOffset atomic.Pointer[OffsetProvider]
+
+// SetOffsetProvider registers a package-wide clock correction applied by Now and
+// by Period.Duration for open-ended periods. The provider is called on every
+// reading, so it should be cheap, e.g. returning a value refreshed in the
+// background. A nil provider removes the correction. Safe for concurrent use.
+func SetOffsetProvider(provider OffsetProvider) {
+	if provider == nil {
+		currentOffset.Store(nil)
+		return
+	}
+	currentOffset.Store(&provider)
+}
+
+// now returns the system time adjusted by the registered OffsetProvider.
+func now() time.Time {
+	t := time.Now()
+	if p := currentOffset.Load(); p != nil {
+		t = t.Add((*p)())
+	}
+	return t
+}
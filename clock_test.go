@@ -0,0 +1,61 @@
+package zeit
+
+import (
+	"testing"
+	"time"
+)
+
+// withOffsetProvider registers a provider for the duration of a test.
+func withOffsetProvider(t *testing.T, provider OffsetProvider) {
+	t.Helper()
+	SetOffsetProvider(provider)
+	t.Cleanup(func() { SetOffsetProvider(nil) })
+}
+
+func TestSetOffsetProvider(t *testing.T) {
+	tests := []struct {
+		name   string
+		offset time.Duration
+	}{
+		{name: "Clock behind", offset: time.Hour},
+		{name: "Clock ahead", offset: -90 * time.Second},
+		{name: "No correction", offset: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withOffsetProvider(t, func() time.Duration { return tt.offset })
+
+			before := time.Now().Add(tt.offset)
+			z := Now(time.UTC)
+			after := time.Now().Add(tt.offset)
+
+			if z.instant.Before(before) || z.instant.After(after) {
+				t.Errorf("Expected between %v and %v, got %v", before, after, z.instant)
+			}
+		})
+	}
+}
+
+func TestSetOffsetProvider_Nil(t *testing.T) {
+	withOffsetProvider(t, func() time.Duration { return 24 * time.Hour })
+	SetOffsetProvider(nil)
+
+	before := time.Now()
+	z := Now(time.UTC)
+	after := time.Now()
+
+	if z.instant.Before(before) || z.instant.After(after) {
+		t.Error("Removing the provider should restore the system clock")
+	}
+}
+
+func TestSetOffsetProvider_OpenPeriodDuration(t *testing.T) {
+	withOffsetProvider(t, func() time.Duration { return time.Hour })
+
+	p := &Period{StartsAt: New(time.Now(), time.UTC)}
+
+	if d := p.Duration(); d < time.Hour || d > time.Hour+time.Minute {
+		t.Errorf("Expected about 1h, got %v", d)
+	}
+}
@@ -0,0 +1,173 @@
+package zeit
+
+import (
+	"fmt"
+	"math"
+)
+
+// Contract is a subscription that starts at StartsAt and renews every Interval
+// until EndsAt. Renewals fall on boundaries aligned to Anchor in the anchor's
+// timezone, so a contract starting mid-month with a month-start anchor has a
+// partial first period. A nil Anchor aligns to StartsAt; a nil EndsAt renews
+// until cancelled.
+type Contract struct {
+	StartsAt *Zeit
+	EndsAt   *Zeit
+	Anchor   *Zeit
+	Interval BillingInterval
+}
+
+// NewContract creates a Contract. end and anchor may be nil.
+func NewContract(start, end *Zeit, interval BillingInterval, anchor *Zeit) *Contract {
+	return &Contract{
+		StartsAt: start,
+		EndsAt:   end,
+		Anchor:   anchor,
+		Interval: interval,
+	}
+}
+
+// Periods generates up to count consecutive periods of the contract, stopping
+// early at EndsAt. A first period shorter than the interval, and a last period
+// cut off at EndsAt, are marked partial.
+func (c *Contract) Periods(count int) []*Period {
+	periods := []*Period{}
+	first := c.boundaryIndex(c.StartsAt)
+
+	for i := range max(count, 0) {
+		p := c.period(first + i)
+		if p == nil {
+			break
+		}
+		periods = append(periods, p)
+	}
+
+	return periods
+}
+
+// CurrentPeriod returns the period of the contract that contains now,
+// or nil if now is before StartsAt or at or after EndsAt.
+func (c *Contract) CurrentPeriod(now *Zeit) *Period {
+	if now.Before(c.StartsAt) || (c.EndsAt != nil && !now.Before(c.EndsAt)) {
+		return nil
+	}
+
+	return c.period(c.boundaryIndex(now))
+}
+
+// Renewals returns up to count renewal dates: the starts of the periods after the
+// first. Renewals at or after EndsAt are not included.
+func (c *Contract) Renewals(count int) []*Zeit {
+	periods := c.Periods(max(count, 0) + 1)
+	if len(periods) < 2 {
+		return []*Zeit{}
+	}
+
+	renewals := make([]*Zeit, 0, len(periods)-1)
+	for _, p := range periods[1:] {
+		renewals = append(renewals, p.StartsAt)
+	}
+	return renewals
+}
+
+// CancellationPolicy decides when a cancellation takes effect.
+type CancellationPolicy int
+
+const (
+	// CancelAtPeriodEnd lets the current period run out; nothing is refunded.
+	CancelAtPeriodEnd CancellationPolicy = iota
+	// CancelImmediately ends the contract at the cancellation time and refunds
+	// the unused share of the current period.
+	CancelImmediately
+)
+
+// Cancellation describes the outcome of cancelling a contract.
+type Cancellation struct {
+	// EffectiveAt is the new end of the contract.
+	EffectiveAt *Zeit
+	// FinalPeriod is the last period of the contract, truncated at EffectiveAt.
+	FinalPeriod *Period
+	// RefundFraction is the unused share of the current period, from 0 to 1,
+	// by elapsed time. Multiply by the period's price to get the refund.
+	RefundFraction float64
+}
+
+// CancelAt computes the effect of cancelling the contract at z under policy.
+// The contract itself is not modified; set EndsAt to EffectiveAt to apply it.
+// Returns ErrOutOfRange if z is not within the contract.
+func (c *Contract) CancelAt(z *Zeit, policy CancellationPolicy) (*Cancellation, error) {
+	current := c.CurrentPeriod(z)
+	if current == nil {
+		return nil, fmt.Errorf("%w: cancellation at %s is outside the contract", ErrOutOfRange, z.ToUser())
+	}
+
+	if policy != CancelImmediately {
+		return &Cancellation{
+			EffectiveAt: current.EndsAt,
+			FinalPeriod: current,
+		}, nil
+	}
+
+	unused := current.EndsAt.instant.Sub(z.instant)
+	return &Cancellation{
+		EffectiveAt:    z,
+		FinalPeriod:    &Period{StartsAt: current.StartsAt, EndsAt: z, Kind: current.Kind, partial: true},
+		RefundFraction: float64(unused) / float64(current.Duration()),
+	}, nil
+}
+
+// period returns the contract period starting at boundary k, clipped to the
+// contract's start and end, or nil if it lies entirely past EndsAt.
+func (c *Contract) period(k int) *Period {
+	start := c.boundary(k)
+	end := c.boundary(k + 1)
+	partial := false
+
+	if start.Before(c.StartsAt) {
+		start = c.StartsAt
+		partial = true
+	}
+	if c.EndsAt != nil {
+		if !start.Before(c.EndsAt) {
+			return nil
+		}
+		if end.After(c.EndsAt) {
+			end = c.EndsAt
+			partial = true
+		}
+	}
+
+	return &Period{StartsAt: start, EndsAt: end, partial: partial}
+}
+
+// boundaryIndex returns the index of the last boundary at or before z. The index
+// is estimated from the time since the anchor and the interval's mean length, then
+// corrected by the boundary or two that uneven months and DST make it miss, so it
+// takes constant time however far z lies from the anchor.
+func (c *Contract) boundaryIndex(z *Zeit) int {
+	length := float64(c.Interval.units()) * 86400 / 4800
+	k := int(math.Floor(float64(z.instant.Unix()-c.anchor().instant.Unix()) / length))
+
+	for c.boundary(k).After(z) {
+		k--
+	}
+	for !c.boundary(k + 1).After(z) {
+		k++
+	}
+	return k
+}
+
+// anchor returns the Anchor, or StartsAt if the contract has none.
+func (c *Contract) anchor() *Zeit {
+	if c.Anchor == nil {
+		return c.StartsAt
+	}
+	return c.Anchor
+}
+
+// boundary returns the k-th renewal boundary counted from the anchor.
+// Each boundary is computed from the anchor so month ends don't drift.
+func (c *Contract) boundary(k int) *Zeit {
+	span := c.Interval.Span()
+	return c.anchor().AddSpan(Span(k*span.Count, span.Unit))
+}
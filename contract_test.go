@@ -0,0 +1,231 @@
+package zeit
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestContract_Periods(t *testing.T) {
+	start := New(time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC), time.UTC)
+	end := New(time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC), time.UTC)
+	monthStart := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	contract := NewContract(start, end, Monthly, monthStart)
+	periods := contract.Periods(12)
+
+	expected := []struct {
+		start   time.Time
+		end     time.Time
+		partial bool
+	}{
+		{time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC), true},
+	}
+
+	if len(periods) != len(expected) {
+		t.Fatalf("Expected %d periods, got %d", len(expected), len(periods))
+	}
+	for i, want := range expected {
+		p := periods[i]
+		if !p.StartsAt.instant.Equal(want.start) || !p.EndsAt.instant.Equal(want.end) {
+			t.Errorf("Period %d: expected %v-%v, got %v-%v", i, want.start, want.end, p.StartsAt.instant, p.EndsAt.instant)
+		}
+		if p.IsPartial() != want.partial {
+			t.Errorf("Period %d: expected partial %v, got %v", i, want.partial, p.IsPartial())
+		}
+	}
+}
+
+func TestContract_OpenEndedWithoutAnchor(t *testing.T) {
+	start := New(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), time.UTC)
+	contract := NewContract(start, nil, Monthly, nil)
+
+	periods := contract.Periods(3)
+
+	if len(periods) != 3 {
+		t.Fatalf("Expected 3 periods, got %d", len(periods))
+	}
+	expectedEnd := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	if !periods[1].EndsAt.instant.Equal(expectedEnd) {
+		t.Errorf("Month ends should not drift: expected %v, got %v", expectedEnd, periods[1].EndsAt.instant)
+	}
+	for i, p := range periods {
+		if p.IsPartial() {
+			t.Errorf("Period %d should not be partial", i)
+		}
+	}
+}
+
+func TestContract_CurrentPeriod(t *testing.T) {
+	start := New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+	end := New(time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+	contract := NewContract(start, end, Monthly, nil)
+
+	tests := []struct {
+		now   time.Time
+		start time.Time
+		name  string
+		isNil bool
+	}{
+		{name: "First day", now: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), start: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{name: "Mid contract", now: time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC), start: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{name: "On renewal", now: time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC), start: time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC)},
+		{name: "Before start", now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), isNil: true},
+		{name: "At end", now: time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC), isNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := contract.CurrentPeriod(New(tt.now, time.UTC))
+			if tt.isNil {
+				if p != nil {
+					t.Errorf("Expected nil, got %v-%v", p.StartsAt.instant, p.EndsAt.instant)
+				}
+				return
+			}
+			if p == nil {
+				t.Fatal("Expected a period, got nil")
+			}
+			if !p.StartsAt.instant.Equal(tt.start) {
+				t.Errorf("Expected start %v, got %v", tt.start, p.StartsAt.instant)
+			}
+			if !p.Contains(New(tt.now, time.UTC)) {
+				t.Error("Current period should contain now")
+			}
+		})
+	}
+}
+
+func TestContract_DistantAnchor(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	anchor := New(time.Date(1900, 1, 31, 0, 0, 0, 0, berlin), berlin)
+
+	tests := []struct {
+		now      time.Time
+		expected time.Time
+		name     string
+		interval BillingInterval
+	}{
+		{name: "Monthly mid-month", interval: Monthly, now: time.Date(2024, 3, 15, 12, 0, 0, 0, berlin), expected: time.Date(2024, 2, 29, 0, 0, 0, 0, berlin)},
+		{name: "Monthly on month end", interval: Monthly, now: time.Date(2024, 3, 31, 0, 0, 0, 0, berlin), expected: time.Date(2024, 3, 31, 0, 0, 0, 0, berlin)},
+		{name: "Quarterly", interval: Quarterly, now: time.Date(2024, 10, 30, 23, 0, 0, 0, berlin), expected: time.Date(2024, 7, 31, 0, 0, 0, 0, berlin)},
+		{name: "Weekly", interval: Weekly, now: time.Date(2024, 1, 15, 0, 0, 0, 0, berlin), expected: time.Date(2024, 1, 10, 0, 0, 0, 0, berlin)},
+		{name: "Daily on DST change day", interval: Daily, now: time.Date(2024, 10, 27, 0, 30, 0, 0, berlin), expected: time.Date(2024, 10, 27, 0, 0, 0, 0, berlin)},
+		{name: "Yearly before anniversary", interval: Yearly, now: time.Date(2024, 1, 30, 0, 0, 0, 0, berlin), expected: time.Date(2023, 1, 31, 0, 0, 0, 0, berlin)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contract := NewContract(anchor, nil, tt.interval, nil)
+			p := contract.CurrentPeriod(New(tt.now, berlin))
+			if p == nil {
+				t.Fatal("Expected a period, got nil")
+			}
+			if !p.StartsAt.instant.Equal(tt.expected) {
+				t.Errorf("Expected start %v, got %v", tt.expected, p.StartsAt.instant)
+			}
+		})
+	}
+}
+
+func TestContract_Renewals(t *testing.T) {
+	start := New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+	end := New(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+	contract := NewContract(start, end, Monthly, nil)
+
+	renewals := contract.Renewals(5)
+
+	if len(renewals) != 1 {
+		t.Fatalf("Expected 1 renewal before the end, got %d", len(renewals))
+	}
+	expected := time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)
+	if !renewals[0].instant.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, renewals[0].instant)
+	}
+}
+
+func TestContract_Renewals_Empty(t *testing.T) {
+	start := New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		end  *Zeit
+		name string
+	}{
+		{start, "Ends at start"},
+		{start.AddDays(-1), "Ends before start"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if renewals := NewContract(start, tt.end, Monthly, nil).Renewals(3); len(renewals) != 0 {
+				t.Errorf("Expected 0 renewals, got %d", len(renewals))
+			}
+		})
+	}
+}
+
+func TestContract_CancelAt(t *testing.T) {
+	start := New(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	contract := NewContract(start, nil, Monthly, nil)
+	// Day 10 of a 30-day April period
+	cancelledAt := New(time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		effective time.Time
+		name      string
+		refund    float64
+		policy    CancellationPolicy
+		partial   bool
+	}{
+		{
+			name:      "At period end",
+			policy:    CancelAtPeriodEnd,
+			effective: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "Immediately",
+			policy:    CancelImmediately,
+			effective: time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC),
+			refund:    21.0 / 30.0,
+			partial:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cancellation, err := contract.CancelAt(cancelledAt, tt.policy)
+			if err != nil {
+				t.Fatalf("CancelAt error: %v", err)
+			}
+
+			if !cancellation.EffectiveAt.instant.Equal(tt.effective) {
+				t.Errorf("Expected effective %v, got %v", tt.effective, cancellation.EffectiveAt.instant)
+			}
+			if !cancellation.FinalPeriod.EndsAt.Equal(cancellation.EffectiveAt) {
+				t.Error("Final period should end at the effective time")
+			}
+			if !cancellation.FinalPeriod.StartsAt.Equal(start) {
+				t.Error("Final period should start at the current period's start")
+			}
+			if cancellation.FinalPeriod.IsPartial() != tt.partial {
+				t.Errorf("Expected partial %v, got %v", tt.partial, cancellation.FinalPeriod.IsPartial())
+			}
+			if math.Abs(cancellation.RefundFraction-tt.refund) > 1e-9 {
+				t.Errorf("Expected refund fraction %v, got %v", tt.refund, cancellation.RefundFraction)
+			}
+		})
+	}
+}
+
+func TestContract_CancelAt_Outside(t *testing.T) {
+	start := New(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	contract := NewContract(start, nil, Monthly, nil)
+
+	before := New(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	if _, err := contract.CancelAt(before, CancelImmediately); err == nil {
+		t.Error("Expected error for cancellation before the contract starts")
+	}
+}
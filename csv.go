@@ -0,0 +1,66 @@
+package zeit
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CSVColumn converts the values of a timestamp column in a CSV file, for
+// encoding/csv pipelines that read and write records as []string. Each file
+// format gets one CSVColumn per timestamp column:
+//
+//	created := zeit.NewCSVColumn("02.01.2006 15:04", berlin)
+//	record[3] = created.Marshal(order.CreatedAt)
+//	order.CreatedAt, err = created.Unmarshal(record[3])
+//
+// Empty fields map to a nil Zeit and back, for nullable columns.
+// A CSVColumn is immutable and safe for concurrent use.
+type CSVColumn struct {
+	format *Formatter
+	loc    *time.Location
+}
+
+// NewCSVColumn creates a CSVColumn for a Go time layout in loc. Values are
+// written in loc, and fields whose layout has no offset are read in loc.
+// A nil loc defaults to UTC.
+func NewCSVColumn(layout string, loc *time.Location) *CSVColumn {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return &CSVColumn{format: NewFormatter(layout, loc), loc: loc}
+}
+
+// Layout returns the column's layout.
+func (c *CSVColumn) Layout() string {
+	return c.format.Layout()
+}
+
+// Marshal returns z formatted for the column, converted to the column's timezone.
+// A nil Zeit returns an empty field.
+func (c *CSVColumn) Marshal(z *Zeit) string {
+	return c.format.Format(z)
+}
+
+// Unmarshal parses a field written with the column's layout. The Zeit is in the
+// column's timezone even if the field carries its own offset. Surrounding spaces
+// are ignored and an empty field returns nil without an error.
+// Returns ErrInvalidFormat for fields that don't match the layout and
+// ErrOutOfRange for times outside the configured valid range.
+func (c *CSVColumn) Unmarshal(field string) (*Zeit, error) {
+	field = strings.TrimSpace(field)
+	if field == "" {
+		return nil, nil
+	}
+
+	t, err := time.ParseInLocation(c.Layout(), field, c.loc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: csv field %q: %w", ErrInvalidFormat, field, err)
+	}
+
+	z := New(t, c.loc)
+	if err := z.Validate(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
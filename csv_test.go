@@ -0,0 +1,105 @@
+package zeit
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCSVColumn_Marshal(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	z := New(time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC), tokyo)
+
+	tests := []struct {
+		loc      *time.Location
+		zeit     *Zeit
+		layout   string
+		expected string
+	}{
+		{layout: "02.01.2006 15:04", loc: berlin, zeit: z, expected: "15.01.2024 10:30"},
+		{layout: time.RFC3339, loc: berlin, zeit: z, expected: "2024-01-15T10:30:00+01:00"},
+		{layout: time.DateTime, loc: nil, zeit: z, expected: "2024-01-15 09:30:00"},
+		{layout: time.DateOnly, loc: berlin, zeit: nil, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			if got := NewCSVColumn(tt.layout, tt.loc).Marshal(tt.zeit); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCSVColumn_Unmarshal(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		expected time.Time
+		name     string
+		layout   string
+		field    string
+	}{
+		{name: "Local layout", layout: "02.01.2006 15:04", field: "15.01.2024 10:30", expected: time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC)},
+		{name: "Summer time", layout: "02.01.2006 15:04", field: "15.07.2024 10:30", expected: time.Date(2024, 7, 15, 8, 30, 0, 0, time.UTC)},
+		{name: "Offset in field", layout: time.RFC3339, field: "2024-01-15T10:30:00-05:00", expected: time.Date(2024, 1, 15, 15, 30, 0, 0, time.UTC)},
+		{name: "Surrounding spaces", layout: time.DateOnly, field: " 2024-01-15 ", expected: time.Date(2024, 1, 14, 23, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z, err := NewCSVColumn(tt.layout, berlin).Unmarshal(tt.field)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !z.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, z.instant)
+			}
+			if z.Location() != berlin {
+				t.Errorf("Expected location %v, got %v", berlin, z.Location())
+			}
+		})
+	}
+}
+
+func TestCSVColumn_UnmarshalEmpty(t *testing.T) {
+	for _, field := range []string{"", "   "} {
+		z, err := NewCSVColumn(time.DateOnly, nil).Unmarshal(field)
+		if z != nil || err != nil {
+			t.Errorf("Expected nil, nil for %q, got %v, %v", field, z, err)
+		}
+	}
+}
+
+func TestCSVColumn_RoundTrip(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	created := NewCSVColumn("02.01.2006 15:04:05", berlin)
+	rows := []*Zeit{
+		New(time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC), time.UTC),
+		nil,
+		New(time.Date(2024, 7, 1, 22, 0, 5, 0, time.UTC), time.UTC),
+	}
+
+	var out strings.Builder
+	w := csv.NewWriter(&out)
+	for i, z := range rows {
+		_ = w.Write([]string{string(rune('a' + i)), created.Marshal(z)})
+	}
+	w.Flush()
+
+	records, err := csv.NewReader(strings.NewReader(out.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll error: %v", err)
+	}
+	for i, record := range records {
+		z, err := created.Unmarshal(record[1])
+		if err != nil {
+			t.Fatalf("Row %d: %v", i, err)
+		}
+		if (z == nil) != (rows[i] == nil) || (z != nil && !z.Equal(rows[i])) {
+			t.Errorf("Row %d: expected %v, got %v", i, rows[i], z)
+		}
+	}
+}
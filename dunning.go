@@ -0,0 +1,125 @@
+package zeit
+
+import (
+	"math"
+	"time"
+)
+
+// RetrySchedule returns the retry attempts for a failed payment, one per offset
+// from failedAt, in failedAt's timezone.
+// With businessDaysOnly, attempts that fall on a weekend, holiday or absence of cal
+// move forward to the next business day at the same local time. A nil cal skips
+// weekends only. Returns ErrNoBusinessDay if an attempt can't be moved, as behind
+// an open-ended absence.
+func RetrySchedule(failedAt *Zeit, offsets []time.Duration, cal *Calendar, businessDaysOnly bool) ([]*Zeit, error) {
+	retries := make([]*Zeit, len(offsets))
+	for i, offset := range offsets {
+		retry, err := rollRetry(failedAt.Add(offset), cal, businessDaysOnly)
+		if err != nil {
+			return nil, err
+		}
+		retries[i] = retry
+	}
+	return retries, nil
+}
+
+// RetryScheduleDays is like RetrySchedule with offsets in calendar days.
+// Days are counted on failedAt's local calendar and the local time of day is kept,
+// so a retry 3 days after a 10:00 failure runs at 10:00 even across DST changes.
+func RetryScheduleDays(failedAt *Zeit, days []int, cal *Calendar, businessDaysOnly bool) ([]*Zeit, error) {
+	retries := make([]*Zeit, len(days))
+	for i, n := range days {
+		retry, err := rollRetry(New(failedAt.Time().AddDate(0, 0, n), failedAt.location), cal, businessDaysOnly)
+		if err != nil {
+			return nil, err
+		}
+		retries[i] = retry
+	}
+	return retries, nil
+}
+
+// BackoffPolicy computes exponentially growing delays between retries.
+// Create via Backoff().
+type BackoffPolicy struct {
+	Base   time.Duration
+	Factor float64
+	Max    time.Duration
+}
+
+// Backoff returns a policy whose first retry waits base and each further retry
+// waits factor times longer, up to maxDelay: Backoff(time.Minute, 2, time.Hour)
+// waits 1m, 2m, 4m, ... 1h, 1h. A factor below 1 is treated as 1, and a maxDelay
+// of zero or less means no cap.
+func Backoff(base time.Duration, factor float64, maxDelay time.Duration) BackoffPolicy {
+	return BackoffPolicy{Base: base, Factor: factor, Max: maxDelay}
+}
+
+// Delay returns the wait before the next try after attempt failed tries.
+// Returns 0 if attempt is not positive.
+func (b BackoffPolicy) Delay(attempt int) time.Duration {
+	if attempt <= 0 {
+		return 0
+	}
+
+	limit := time.Duration(math.MaxInt64)
+	if b.Max > 0 {
+		limit = b.Max
+	}
+
+	delay := float64(b.Base) * math.Pow(max(b.Factor, 1), float64(attempt-1))
+	if delay >= float64(limit) {
+		return limit
+	}
+	return time.Duration(delay)
+}
+
+// Next returns when to retry after attempt failed tries, counted from the
+// last failure at from, in from's timezone.
+func (b BackoffPolicy) Next(from *Zeit, attempt int) *Zeit {
+	return from.Add(b.Delay(attempt))
+}
+
+// Reminder returns when to send a renewal notice: lead before the period ends.
+// With businessDaysOnly, a reminder that falls on a weekend, holiday or absence of
+// cal moves back to the previous business day at the same local time, so the
+// notice never goes out later than promised. Returns nil for open-ended periods,
+// and ErrNoBusinessDay if the reminder can't be moved to a business day.
+func (p *Period) Reminder(lead time.Duration, cal *Calendar, businessDaysOnly bool) (*Zeit, error) {
+	if p.EndsAt == nil {
+		return nil, nil
+	}
+	return rollReminder(p.EndsAt.Add(-lead), cal, businessDaysOnly)
+}
+
+// ReminderDays is like Reminder with the lead time in calendar days, counted on the
+// local calendar of the period end and keeping its local time of day.
+func (p *Period) ReminderDays(days int, cal *Calendar, businessDaysOnly bool) (*Zeit, error) {
+	if p.EndsAt == nil {
+		return nil, nil
+	}
+	return rollReminder(New(p.EndsAt.Time().AddDate(0, 0, -days), p.EndsAt.location), cal, businessDaysOnly)
+}
+
+// rollReminder moves a reminder back to the previous business day when requested.
+func rollReminder(z *Zeit, cal *Calendar, businessDaysOnly bool) (*Zeit, error) {
+	if !businessDaysOnly {
+		return z, nil
+	}
+	t, err := cal.previousBusinessDay(z.Time())
+	if err != nil {
+		return nil, err
+	}
+	return New(t, z.location), nil
+}
+
+// rollRetry moves a retry to the next business day when requested.
+func rollRetry(z *Zeit, cal *Calendar, businessDaysOnly bool) (*Zeit, error) {
+	if !businessDaysOnly {
+		return z, nil
+	}
+	t, err := cal.nextBusinessDay(z.Time())
+	if err != nil {
+		return nil, err
+	}
+	return New(t, z.location), nil
+}
@@ -0,0 +1,211 @@
+package zeit
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRetrySchedule(t *testing.T) {
+	// Failed Thursday Dec 19, 2024 at 10:00
+	failedAt := New(time.Date(2024, 12, 19, 10, 0, 0, 0, time.UTC), time.UTC)
+	offsets := []time.Duration{24 * time.Hour, 48 * time.Hour, 6 * 24 * time.Hour}
+	christmas := NewCalendar(Holiday{Name: "Christmas", Month: time.December, Day: 25})
+
+	tests := []struct {
+		cal              *Calendar
+		name             string
+		expected         []time.Time
+		businessDaysOnly bool
+	}{
+		{
+			name: "No rolling",
+			expected: []time.Time{
+				time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, 12, 21, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, 12, 25, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:             "Weekends skipped with nil calendar",
+			businessDaysOnly: true,
+			expected: []time.Time{
+				time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, 12, 25, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:             "Holidays skipped",
+			cal:              christmas,
+			businessDaysOnly: true,
+			expected: []time.Time{
+				time.Date(2024, 12, 20, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, 12, 26, 10, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retries, err := RetrySchedule(failedAt, offsets, tt.cal, tt.businessDaysOnly)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(retries) != len(tt.expected) {
+				t.Fatalf("Expected %d retries, got %d", len(tt.expected), len(retries))
+			}
+			for i, want := range tt.expected {
+				if !retries[i].instant.Equal(want) {
+					t.Errorf("Retry %d: expected %v, got %v", i, want, retries[i].instant)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryScheduleDays_KeepsLocalTime(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	// Failed Thursday Mar 28, 2024 at 10:00 Berlin; DST starts Sunday Mar 31
+	failedAt := New(time.Date(2024, 3, 28, 10, 0, 0, 0, berlin), berlin)
+
+	retries, err := RetryScheduleDays(failedAt, []int{1, 3, 5}, nil, true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []time.Time{
+		time.Date(2024, 3, 29, 10, 0, 0, 0, berlin),
+		time.Date(2024, 4, 1, 10, 0, 0, 0, berlin), // Sunday rolls to Monday
+		time.Date(2024, 4, 2, 10, 0, 0, 0, berlin),
+	}
+	for i, want := range expected {
+		if !retries[i].instant.Equal(want) {
+			t.Errorf("Retry %d: expected %v, got %v", i, want.UTC(), retries[i].instant)
+		}
+		if retries[i].Location() != berlin {
+			t.Errorf("Retry %d should keep the failure's location", i)
+		}
+	}
+}
+
+func TestRetrySchedule_Empty(t *testing.T) {
+	failedAt := Now(time.UTC)
+
+	if retries, _ := RetrySchedule(failedAt, nil, nil, true); len(retries) != 0 {
+		t.Errorf("Expected 0 retries, got %d", len(retries))
+	}
+}
+
+func TestBackoff_Delay(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   BackoffPolicy
+		attempt  int
+		expected time.Duration
+	}{
+		{name: "First retry waits base", policy: Backoff(time.Minute, 2, time.Hour), attempt: 1, expected: time.Minute},
+		{name: "Grows by factor", policy: Backoff(time.Minute, 2, time.Hour), attempt: 4, expected: 8 * time.Minute},
+		{name: "Capped at max", policy: Backoff(time.Minute, 2, time.Hour), attempt: 7, expected: time.Hour},
+		{name: "Fractional factor", policy: Backoff(time.Second, 1.5, 0), attempt: 3, expected: 2250 * time.Millisecond},
+		{name: "Factor below 1 is constant", policy: Backoff(time.Minute, 0.5, 0), attempt: 5, expected: time.Minute},
+		{name: "No cap does not overflow", policy: Backoff(time.Second, 10, 0), attempt: 100, expected: time.Duration(math.MaxInt64)},
+		{name: "No failures yet", policy: Backoff(time.Minute, 2, time.Hour), attempt: 0, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Delay(tt.attempt); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestBackoff_Next(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	failedAt := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), berlin)
+
+	next := Backoff(time.Minute, 2, time.Hour).Next(failedAt, 3)
+
+	expected := time.Date(2024, 1, 15, 10, 4, 0, 0, time.UTC)
+	if !next.instant.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, next.instant)
+	}
+	if next.Location() != berlin {
+		t.Error("Next should keep the failure's location")
+	}
+}
+
+func TestPeriod_Reminder(t *testing.T) {
+	// Renews Monday Jan 1, 2024 at midnight
+	p := &Period{
+		StartsAt: New(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), time.UTC),
+		EndsAt:   New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC),
+	}
+	christmas := NewCalendar(
+		Holiday{Name: "Christmas", Month: time.December, Day: 25},
+		Holiday{Name: "Boxing Day", Month: time.December, Day: 26},
+	)
+
+	tests := []struct {
+		cal              *Calendar
+		expected         time.Time
+		name             string
+		businessDaysOnly bool
+	}{
+		{
+			name:     "Calendar days",
+			expected: time.Date(2023, 12, 24, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Sunday rolls back to Friday",
+			businessDaysOnly: true,
+			expected:         time.Date(2023, 12, 22, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Holidays skipped",
+			cal:              christmas,
+			businessDaysOnly: true,
+			expected:         time.Date(2023, 12, 22, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			byDuration, err := p.Reminder(8*24*time.Hour, tt.cal, tt.businessDaysOnly)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !byDuration.instant.Equal(tt.expected) {
+				t.Errorf("Reminder: expected %v, got %v", tt.expected, byDuration.instant)
+			}
+
+			byDays, err := p.ReminderDays(8, tt.cal, tt.businessDaysOnly)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !byDays.instant.Equal(tt.expected) {
+				t.Errorf("ReminderDays: expected %v, got %v", tt.expected, byDays.instant)
+			}
+		})
+	}
+
+	if boxingDay, _ := p.ReminderDays(6, christmas, true); boxingDay.instant.Day() != 22 {
+		t.Error("Boxing Day reminder should roll back past Christmas and the weekend")
+	}
+}
+
+func TestPeriod_Reminder_OpenEnded(t *testing.T) {
+	p := &Period{StartsAt: Now(time.UTC)}
+
+	byDuration, err := p.Reminder(time.Hour, nil, false)
+	if byDuration != nil || err != nil {
+		t.Error("Expected nil reminder for open-ended period")
+	}
+	if byDays, err := p.ReminderDays(1, nil, false); byDays != nil || err != nil {
+		t.Error("Expected nil reminder for open-ended period")
+	}
+}
@@ -1,21 +1,56 @@
 package zeit
 
-import "time"
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // Duration represents the distance between two Zeit instances.
 // Provides multiple unit views of the same span.
-// Create via Zeit.Until() or NewDuration().
+// Create via Zeit.Until(), NewDuration() or DurationOf(). For abstract lengths that are not
+// tied to two instants ("3 days", "1 month"), use Span().
 type Duration struct {
 	start *Zeit
 	end   *Zeit
 }
 
 // NewDuration creates a Duration between two Zeit instances.
-// Deprecated: Use start.Until(end) instead.
+// Equivalent to start.Until(end).
 func NewDuration(start, end *Zeit) *Duration {
 	return &Duration{start: start, end: end}
 }
 
+// DurationOf creates a Duration of length raw that is not tied to real instants,
+// e.g. for a measured latency or a configured TTL. Like a scanned Duration it
+// starts at the Unix epoch in UTC, so calendar views such as Months and
+// BusinessDays are measured from Jan 1, 1970; use DurationFrom when they matter.
+// A negative raw gives a reversed Duration.
+func DurationOf(raw time.Duration) *Duration {
+	return DurationFrom(FromDatabase(0, time.UTC), raw)
+}
+
+// DurationFrom creates a Duration of length raw starting at start.
+// A negative raw gives a reversed Duration that ends before start.
+func DurationFrom(start *Zeit, raw time.Duration) *Duration {
+	return &Duration{start: start, end: start.Add(raw)}
+}
+
+// RoundingMode controls how partial units are counted by the *Rounded accessors.
+type RoundingMode int
+
+const (
+	// RoundTruncate drops partial units (22 hours = 0 days), like Days() and Hours().
+	RoundTruncate RoundingMode = iota
+	// RoundNearest rounds half units up (12 hours = 1 day).
+	RoundNearest
+	// RoundUp counts any partial unit as a whole one (1 minute = 1 day).
+	RoundUp
+)
+
 // Days returns the total number of calendar days in the duration.
 // Truncates partial days (22 hours = 0 days).
 func (d *Duration) Days() int {
@@ -37,6 +72,21 @@ func (d *Duration) Seconds() int {
 	return int(d.raw().Seconds())
 }
 
+// DaysRounded returns the number of 24-hour days, rounding partial days by mode.
+func (d *Duration) DaysRounded(mode RoundingMode) int {
+	return roundUnits(d.raw(), 24*time.Hour, mode)
+}
+
+// HoursRounded returns the number of hours, rounding partial hours by mode.
+func (d *Duration) HoursRounded(mode RoundingMode) int {
+	return roundUnits(d.raw(), time.Hour, mode)
+}
+
+// MinutesRounded returns the number of minutes, rounding partial minutes by mode.
+func (d *Duration) MinutesRounded(mode RoundingMode) int {
+	return roundUnits(d.raw(), time.Minute, mode)
+}
+
 // Months returns the number of whole calendar months between start and end.
 // Accounts for varying month lengths (28-31 days).
 func (d *Duration) Months() int {
@@ -60,31 +110,158 @@ func (d *Duration) Months() int {
 
 // BusinessDays returns the number of business days (Mon-Fri) in the duration.
 // Uses [start, end) semantics: start day is counted, end day is not.
+// Runs in constant time regardless of the length of the duration.
 func (d *Duration) BusinessDays() int {
-	start, end := d.ordered()
+	startDate, endDate := d.dates()
+	return MonToFri.countBetween(startDate, endDate)
+}
 
-	// Normalize to date boundaries
-	startDate := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
-	endDate := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+// BusinessDaysIn is like BusinessDays but also excludes the holidays and absences
+// of cal. Runs in time proportional to the number of holidays and absence days
+// in the range, not its length, so multi-year contracts count quickly.
+// A nil cal is equivalent to BusinessDays.
+func (d *Duration) BusinessDaysIn(cal *Calendar) int {
+	startDate, endDate := d.dates()
+	count := MonToFri.countBetween(startDate, endDate)
 
-	if !startDate.Before(endDate) {
-		return 0
+	for _, day := range cal.closureDates(startDate, endDate) {
+		if MonToFri.Contains(day.Weekday()) && !cal.isBusinessDay(day) {
+			count--
+		}
 	}
 
-	totalDays := int(endDate.Sub(startDate).Hours() / 24)
-	fullWeeks := totalDays / 7
-	remaining := totalDays % 7
+	return count
+}
 
-	count := fullWeeks * 5
+// SplitByMonth splits the duration at the calendar month boundaries of its start's
+// timezone and returns one Duration per month it touches, in order, for revenue and
+// usage attributed per month. The parts add up to the whole; the first and last are
+// partial unless the duration starts or ends at local midnight on the 1st.
+// A reversed Duration is split like its forward counterpart, still in the start's
+// timezone. Returns an empty slice for an empty duration.
+func (d *Duration) SplitByMonth() []*Duration {
+	start, end := d.start, d.end
+	if end.Before(start) {
+		start, end = end.In(start.location), start
+	}
 
-	for i := range remaining {
-		day := startDate.AddDate(0, 0, fullWeeks*7+i).Weekday()
-		if day != time.Saturday && day != time.Sunday {
-			count++
+	periods := start.MonthsUntil(end)
+	parts := make([]*Duration, len(periods))
+	for i, p := range periods {
+		parts[i] = &Duration{start: p.StartsAt, end: p.EndsAt}
+	}
+	return parts
+}
+
+// AddDuration returns a new Zeit moved by the calendar distance of d.
+// The distance is measured in months, days and a clock remainder in d's start
+// timezone, then applied in the Zeit's timezone, so a Duration from Jan 15 to
+// Feb 15 adds one month rather than 31 days. Months are measured and applied with
+// the same clamping as AddSpan, so z.AddDuration(z.Until(e)) equals e even from a
+// month end, and wall-clock times that a DST change skips or repeats resolve like
+// AddDate. A reversed Duration (start after end) moves backwards.
+func (z *Zeit) AddDuration(d *Duration) *Zeit {
+	diff, negative := d.calendar()
+	if negative {
+		return New(diff.subtractFrom(z.Time()), z.location)
+	}
+	return New(diff.addTo(z.Time()), z.location)
+}
+
+// SubDuration returns a new Zeit moved backwards by the calendar distance of d.
+// It undoes AddDuration except where a month end was clamped.
+func (z *Zeit) SubDuration(d *Duration) *Zeit {
+	diff, negative := d.calendar()
+	if negative {
+		return New(diff.addTo(z.Time()), z.location)
+	}
+	return New(diff.subtractFrom(z.Time()), z.location)
+}
+
+// DayBreakdown counts the days of a Duration by kind.
+// Every day falls into exactly one bucket; holidays on a weekend count as
+// Saturdays or Sundays.
+type DayBreakdown struct {
+	Weekdays  int // Monday to Friday, excluding holidays
+	Saturdays int
+	Sundays   int
+	Holidays  int // holidays falling on Monday to Friday
+}
+
+// DayBreakdown counts weekdays, Saturdays, Sundays and holidays of cal in the duration,
+// for staffing and payroll summaries. Uses the same [start, end) day semantics as
+// BusinessDays. A nil cal has no holidays.
+func (d *Duration) DayBreakdown(cal *Calendar) DayBreakdown {
+	day, endDate := d.dates()
+
+	var b DayBreakdown
+	for ; day.Before(endDate); day = day.AddDate(0, 0, 1) {
+		switch {
+		case day.Weekday() == time.Saturday:
+			b.Saturdays++
+		case day.Weekday() == time.Sunday:
+			b.Sundays++
+		case cal.isHoliday(day):
+			b.Holidays++
+		default:
+			b.Weekdays++
 		}
 	}
 
-	return count
+	return b
+}
+
+// String formats the duration in exact units for logs and API payloads, e.g.
+// "14d 3h" or "2h 30m 15s". Days are 24 hours; zero units are omitted and
+// sub-second remainders are dropped. A reversed duration has a leading "-",
+// a duration under one second is "0s". A nil Duration returns an empty string.
+func (d *Duration) String() string {
+	if d == nil {
+		return ""
+	}
+
+	var parts []string
+	units := []struct {
+		size   time.Duration
+		suffix string
+	}{
+		{24 * time.Hour, "d"},
+		{time.Hour, "h"},
+		{time.Minute, "m"},
+		{time.Second, "s"},
+	}
+	rest := d.raw()
+	for _, u := range units {
+		if n := rest / u.size; n > 0 {
+			parts = append(parts, strconv.FormatInt(int64(n), 10)+u.suffix)
+			rest -= n * u.size
+		}
+	}
+
+	if len(parts) == 0 {
+		return "0s"
+	}
+	if d.end.Before(d.start) {
+		return "-" + strings.Join(parts, " ")
+	}
+	return strings.Join(parts, " ")
+}
+
+// ISO8601 formats the duration as an ISO 8601 duration in calendar units, e.g.
+// "P1M" from Jan 15 to Feb 15 or "P1DT2H". Months and days are measured on the
+// calendar of the start's timezone, as with AddDuration, and months clamp at month
+// ends like AddSpan: Jan 31 to Mar 2 is "P1M2D". A reversed duration has
+// a leading "-", a zero duration is "PT0S". A nil Duration returns an empty string.
+func (d *Duration) ISO8601() string {
+	if d == nil {
+		return ""
+	}
+
+	diff, negative := d.calendar()
+	if negative {
+		return "-" + diff.String()
+	}
+	return diff.String()
 }
 
 // Raw returns the underlying time.Duration.
@@ -92,6 +269,74 @@ func (d *Duration) Raw() time.Duration {
 	return d.raw()
 }
 
+// Value implements driver.Valuer for database storage.
+// Stores the signed length in whole seconds (end minus start) as int64.
+// A nil Duration stores NULL.
+func (d *Duration) Value() (driver.Value, error) {
+	if d == nil {
+		return nil, nil
+	}
+	return d.signedSeconds(), nil
+}
+
+// Scan implements sql.Scanner for database reading.
+// Reads int64 seconds; float64 is truncated, as some SQLite drivers deliver
+// INTEGER columns as float64. A scanned Duration keeps the length but not the
+// original instants: it starts at the Unix epoch in UTC, so calendar views
+// such as Months and BusinessDays are measured from Jan 1, 1970.
+func (d *Duration) Scan(src any) error {
+	switch v := src.(type) {
+	case int64:
+		d.setSeconds(v)
+		return nil
+	case float64:
+		d.setSeconds(int64(v))
+		return nil
+	case nil:
+		return fmt.Errorf("%w: cannot scan NULL into Duration", ErrNilValue)
+	default:
+		return fmt.Errorf("%w: cannot scan %T into Duration", ErrUnsupportedScanType, src)
+	}
+}
+
+// MarshalJSON implements json.Marshaler. Encodes the signed length in whole
+// seconds as a JSON number, e.g. for a "remaining_seconds" field. For a
+// human-readable field, marshal String() or ISO8601() instead.
+// A nil Duration marshals to null.
+func (d *Duration) MarshalJSON() ([]byte, error) {
+	if d == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.signedSeconds())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Decodes a number of seconds with
+// the same epoch-based instants as Scan. A JSON null leaves d unchanged.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var seconds float64
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return err
+	}
+
+	d.setSeconds(int64(seconds))
+	return nil
+}
+
+// signedSeconds returns end minus start in whole seconds, truncated toward zero.
+func (d *Duration) signedSeconds() int64 {
+	return int64(d.end.instant.Sub(d.start.instant) / time.Second)
+}
+
+// setSeconds replaces the instants with a span of seconds starting at the Unix epoch.
+func (d *Duration) setSeconds(seconds int64) {
+	d.start = FromDatabase(0, time.UTC)
+	d.end = FromDatabase(seconds, time.UTC)
+}
+
 // raw returns the absolute duration between start and end.
 func (d *Duration) raw() time.Duration {
 	diff := d.end.instant.Sub(d.start.instant)
@@ -101,6 +346,12 @@ func (d *Duration) raw() time.Duration {
 	return diff
 }
 
+// dates returns the UTC calendar dates of the ordered start and end.
+func (d *Duration) dates() (time.Time, time.Time) {
+	start, end := d.ordered()
+	return civilDate(start), civilDate(end)
+}
+
 // ordered returns start and end as time.Time with start <= end.
 func (d *Duration) ordered() (time.Time, time.Time) {
 	s := d.start.instant
@@ -110,3 +361,28 @@ func (d *Duration) ordered() (time.Time, time.Time) {
 	}
 	return s, e
 }
+
+// calendar splits the duration into calendar components in the start's timezone,
+// counted from start towards end. negative reports whether end lies before start.
+func (d *Duration) calendar() (diff isoDuration, negative bool) {
+	return calendarDiff(d.start.Time(), d.end.instant), d.end.Before(d.start)
+}
+
+// roundUnits divides a non-negative duration into units, rounding the remainder by mode.
+func roundUnits(raw, unit time.Duration, mode RoundingMode) int {
+	whole := int(raw / unit)
+	remainder := raw % unit
+
+	switch mode {
+	case RoundNearest:
+		if remainder >= unit-remainder {
+			whole++
+		}
+	case RoundUp:
+		if remainder > 0 {
+			whole++
+		}
+	}
+
+	return whole
+}
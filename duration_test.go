@@ -1,6 +1,7 @@
 package zeit
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 )
@@ -19,6 +20,55 @@ func TestUntil(t *testing.T) {
 	}
 }
 
+func TestDurationOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     time.Duration
+		days    int
+		hours   int
+		display string
+	}{
+		{name: "Config TTL", raw: 36 * time.Hour, days: 1, hours: 36, display: "1d 12h"},
+		{name: "Latency", raw: 1500 * time.Millisecond, days: 0, hours: 0, display: "1s"},
+		{name: "Negative", raw: -2 * time.Hour, days: 0, hours: 2, display: "-2h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := DurationOf(tt.raw)
+
+			if d.Days() != tt.days || d.Hours() != tt.hours {
+				t.Errorf("Expected %d days %d hours, got %d days %d hours", tt.days, tt.hours, d.Days(), d.Hours())
+			}
+			if d.String() != tt.display {
+				t.Errorf("Expected %s, got %s", tt.display, d.String())
+			}
+			if d.start.ToDatabase() != 0 {
+				t.Errorf("Expected start at the Unix epoch, got %v", d.start.instant)
+			}
+		})
+	}
+}
+
+func TestDurationFrom(t *testing.T) {
+	// Friday, January 19, 2024
+	start := New(time.Date(2024, 1, 19, 9, 0, 0, 0, time.UTC), time.UTC)
+
+	d := DurationFrom(start, 72*time.Hour)
+
+	if d.Raw() != 72*time.Hour {
+		t.Errorf("Expected 72h, got %v", d.Raw())
+	}
+	if d.BusinessDays() != 1 {
+		t.Errorf("Expected 1 business day from Friday, got %d", d.BusinessDays())
+	}
+
+	reversed := DurationFrom(start, -24*time.Hour)
+	if !reversed.end.Before(start) || reversed.Days() != 1 {
+		t.Errorf("Expected a reversed one-day duration, got %v", reversed)
+	}
+}
+
 func TestDuration_Days(t *testing.T) {
 	tests := []struct {
 		start    time.Time
@@ -388,3 +438,459 @@ func TestDuration_Proration(t *testing.T) {
 		t.Errorf("Expected prorated price ~45.16, got %.2f", proratedPrice)
 	}
 }
+
+func TestDuration_SplitByMonth(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	at := func(month, day, hour int) *Zeit {
+		return New(time.Date(2024, time.Month(month), day, hour, 0, 0, 0, berlin), berlin)
+	}
+
+	tests := []struct {
+		duration *Duration
+		bounds   []*Zeit
+		name     string
+	}{
+		{name: "Three months", duration: at(1, 15, 10).Until(at(3, 10, 0)), bounds: []*Zeit{at(1, 15, 10), at(2, 1, 0), at(3, 1, 0), at(3, 10, 0)}},
+		{name: "Within a month", duration: at(2, 3, 0).Until(at(2, 5, 0)), bounds: []*Zeit{at(2, 3, 0), at(2, 5, 0)}},
+		{name: "Whole months", duration: at(3, 1, 0).Until(at(5, 1, 0)), bounds: []*Zeit{at(3, 1, 0), at(4, 1, 0), at(5, 1, 0)}},
+		{name: "Reversed", duration: at(3, 10, 0).Until(at(2, 20, 0)), bounds: []*Zeit{at(2, 20, 0), at(3, 1, 0), at(3, 10, 0)}},
+		{name: "Local month start, not UTC", duration: at(1, 31, 12).Until(at(2, 1, 0).Add(30 * time.Minute)), bounds: []*Zeit{at(1, 31, 12), at(2, 1, 0), at(2, 1, 0).Add(30 * time.Minute)}},
+		{name: "Empty", duration: at(1, 15, 0).Until(at(1, 15, 0)), bounds: []*Zeit{at(1, 15, 0)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts := tt.duration.SplitByMonth()
+			if len(parts) != len(tt.bounds)-1 {
+				t.Fatalf("Expected %d parts, got %d", len(tt.bounds)-1, len(parts))
+			}
+
+			var total time.Duration
+			for i, part := range parts {
+				if !part.start.Equal(tt.bounds[i]) || !part.end.Equal(tt.bounds[i+1]) {
+					t.Errorf("Part %d: expected %v to %v, got %v to %v", i, tt.bounds[i].Time(), tt.bounds[i+1].Time(), part.start.Time(), part.end.Time())
+				}
+				total += part.Raw()
+			}
+			if total != tt.duration.Raw() {
+				t.Errorf("Expected parts to add up to %v, got %v", tt.duration.Raw(), total)
+			}
+		})
+	}
+}
+
+func TestAddDuration(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		from     time.Time
+		to       time.Time
+		start    time.Time
+		expected time.Time
+		name     string
+		clamped  bool
+	}{
+		{
+			name:     "One month adds a calendar month",
+			from:     time.Date(2024, 1, 15, 0, 0, 0, 0, berlin),
+			to:       time.Date(2024, 2, 15, 0, 0, 0, 0, berlin),
+			start:    time.Date(2024, 4, 10, 9, 0, 0, 0, berlin),
+			expected: time.Date(2024, 5, 10, 9, 0, 0, 0, berlin),
+		},
+		{
+			name:     "Month end clamps",
+			from:     time.Date(2024, 1, 15, 0, 0, 0, 0, berlin),
+			to:       time.Date(2024, 2, 15, 0, 0, 0, 0, berlin),
+			start:    time.Date(2024, 1, 31, 9, 0, 0, 0, berlin),
+			expected: time.Date(2024, 2, 29, 9, 0, 0, 0, berlin),
+			clamped:  true,
+		},
+		{
+			name:     "Days and clock",
+			from:     time.Date(2024, 1, 1, 0, 0, 0, 0, berlin),
+			to:       time.Date(2024, 1, 3, 6, 30, 0, 0, berlin),
+			start:    time.Date(2024, 3, 30, 12, 0, 0, 0, berlin),
+			expected: time.Date(2024, 4, 1, 18, 30, 0, 0, berlin),
+		},
+		{
+			name:     "Reversed duration moves backwards",
+			from:     time.Date(2024, 2, 15, 0, 0, 0, 0, berlin),
+			to:       time.Date(2024, 1, 15, 0, 0, 0, 0, berlin),
+			start:    time.Date(2024, 5, 10, 9, 0, 0, 0, berlin),
+			expected: time.Date(2024, 4, 10, 9, 0, 0, 0, berlin),
+		},
+		{
+			name:     "Repeated wall-clock time resolves like AddDate",
+			from:     time.Date(2024, 1, 1, 0, 0, 0, 0, berlin),
+			to:       time.Date(2024, 1, 2, 0, 0, 0, 0, berlin),
+			start:    time.Date(2024, 10, 26, 2, 30, 0, 0, berlin),
+			expected: time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC), // 02:30 CEST
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := New(tt.from, berlin).Until(New(tt.to, berlin))
+
+			result := New(tt.start, berlin).AddDuration(d)
+			if !result.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected.UTC(), result.instant)
+			}
+
+			back := result.SubDuration(d)
+			if !tt.clamped && !back.instant.Equal(tt.start) {
+				t.Errorf("SubDuration: expected %v, got %v", tt.start.UTC(), back.instant)
+			}
+		})
+	}
+}
+
+func TestAddDuration_RoundTrip(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		start time.Time
+		end   time.Time
+		name  string
+	}{
+		{time.Date(2024, 1, 15, 10, 0, 0, 0, berlin), time.Date(2024, 2, 15, 10, 0, 0, 0, berlin), "Mid-month"},
+		{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), "Month end past February"},
+		{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), "Month end to leap day"},
+		{time.Date(2024, 1, 30, 9, 0, 0, 0, berlin), time.Date(2024, 3, 1, 8, 0, 0, 0, berlin), "Day 30 across February"},
+		{time.Date(2024, 2, 29, 9, 0, 0, 0, berlin), time.Date(2025, 3, 1, 9, 0, 0, 0, berlin), "Leap day to next year"},
+		{time.Date(2024, 3, 31, 12, 0, 0, 0, berlin), time.Date(2024, 4, 30, 12, 0, 0, 0, berlin), "Month end across DST"},
+		{time.Date(2024, 8, 31, 23, 0, 0, 0, berlin), time.Date(2024, 12, 1, 1, 30, 0, 0, berlin), "Month end to short month"},
+		{time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), "Reversed from a month end"},
+		{time.Date(2024, 4, 30, 18, 0, 0, 0, berlin), time.Date(2024, 1, 31, 6, 0, 0, 0, berlin), "Reversed across DST and month ends"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z, e := New(tt.start, tt.start.Location()), New(tt.end, tt.start.Location())
+			if got := z.AddDuration(z.Until(e)); !got.Equal(e) {
+				t.Errorf("Expected %v, got %v", e.instant, got.instant)
+			}
+		})
+	}
+}
+
+func TestDuration_Rounded(t *testing.T) {
+	tests := []struct {
+		name     string
+		duration time.Duration
+		mode     RoundingMode
+		days     int
+		hours    int
+		minutes  int
+	}{
+		{"Truncate partial day", 22 * time.Hour, RoundTruncate, 0, 22, 1320},
+		{"Up counts any part", 24*time.Hour + time.Second, RoundUp, 2, 25, 1441},
+		{"Up keeps exact units", 48 * time.Hour, RoundUp, 2, 48, 2880},
+		{"Nearest below half", 11*time.Hour + 29*time.Minute, RoundNearest, 0, 11, 689},
+		{"Nearest at half", 12*time.Hour + 30*time.Minute + 30*time.Second, RoundNearest, 1, 13, 751},
+		{"Zero", 0, RoundUp, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+			d := start.Until(start.Add(tt.duration))
+
+			if result := d.DaysRounded(tt.mode); result != tt.days {
+				t.Errorf("Expected %d days, got %d", tt.days, result)
+			}
+			if result := d.HoursRounded(tt.mode); result != tt.hours {
+				t.Errorf("Expected %d hours, got %d", tt.hours, result)
+			}
+			if result := d.MinutesRounded(tt.mode); result != tt.minutes {
+				t.Errorf("Expected %d minutes, got %d", tt.minutes, result)
+			}
+		})
+	}
+}
+
+func TestDuration_DayBreakdown(t *testing.T) {
+	newYear := NewCalendar(
+		Holiday{Name: "New Year", Month: time.January, Day: 1},
+		Holiday{Name: "Epiphany", Month: time.January, Day: 6}, // Saturday in 2024
+	)
+
+	tests := []struct {
+		cal      *Calendar
+		start    time.Time
+		end      time.Time
+		name     string
+		expected DayBreakdown
+	}{
+		{
+			name:     "Two weeks without calendar",
+			start:    time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), // Monday
+			end:      time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+			expected: DayBreakdown{Weekdays: 10, Saturdays: 2, Sundays: 2},
+		},
+		{
+			name:     "Weekday holidays counted separately",
+			cal:      newYear,
+			start:    time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+			end:      time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+			expected: DayBreakdown{Weekdays: 9, Saturdays: 2, Sundays: 2, Holidays: 1},
+		},
+		{
+			name:     "Reversed",
+			start:    time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), // Friday
+			expected: DayBreakdown{Weekdays: 1, Saturdays: 1, Sundays: 1},
+		},
+		{
+			name:  "Same day",
+			start: time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 1, 8, 17, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := New(tt.start, time.UTC).Until(New(tt.end, time.UTC))
+
+			result := d.DayBreakdown(tt.cal)
+			if result != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, result)
+			}
+			if tt.cal == nil && result.Weekdays != d.BusinessDays() {
+				t.Errorf("Weekdays %d should match BusinessDays %d", result.Weekdays, d.BusinessDays())
+			}
+		})
+	}
+}
+
+func TestDuration_ValueScan(t *testing.T) {
+	start := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), time.UTC)
+	d := start.Until(start.Add(90*time.Minute + 500*time.Millisecond))
+
+	value, err := d.Value()
+	if err != nil {
+		t.Fatalf("Value error: %v", err)
+	}
+	if value != int64(5400) {
+		t.Errorf("Expected 5400 seconds, got %v", value)
+	}
+
+	reversed, _ := start.Add(time.Hour).Until(start).Value()
+	if reversed != int64(-3600) {
+		t.Errorf("Expected -3600 seconds for reversed duration, got %v", reversed)
+	}
+
+	var scanned Duration
+	for _, src := range []any{int64(5400), float64(5400)} {
+		if err := scanned.Scan(src); err != nil {
+			t.Fatalf("Scan(%T) error: %v", src, err)
+		}
+		if scanned.Minutes() != 90 {
+			t.Errorf("Scan(%T): expected 90 minutes, got %d", src, scanned.Minutes())
+		}
+	}
+
+	if err := scanned.Scan(nil); err == nil {
+		t.Error("Expected error scanning nil")
+	}
+	if err := scanned.Scan("5400"); err == nil {
+		t.Error("Expected error scanning string")
+	}
+}
+
+func TestDuration_JSON(t *testing.T) {
+	type quota struct {
+		Remaining *Duration `json:"remaining_seconds"`
+	}
+
+	start := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), time.UTC)
+	in := quota{Remaining: start.Until(start.Add(2 * time.Hour))}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(data) != `{"remaining_seconds":7200}` {
+		t.Errorf("Expected {\"remaining_seconds\":7200}, got %s", data)
+	}
+
+	var out quota
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if out.Remaining.Raw() != 2*time.Hour {
+		t.Errorf("Expected 2h, got %v", out.Remaining.Raw())
+	}
+
+	if err := json.Unmarshal([]byte(`{"remaining_seconds":null}`), &out); err != nil || out.Remaining != nil {
+		t.Errorf("Expected nil duration for null, got %v (err %v)", out.Remaining, err)
+	}
+	if err := json.Unmarshal([]byte(`{"remaining_seconds":"2h"}`), &out); err == nil {
+		t.Error("Expected error for string input")
+	}
+}
+
+func TestDuration_NilReceiver(t *testing.T) {
+	var d *Duration
+
+	if value, err := d.Value(); value != nil || err != nil {
+		t.Errorf("Value on nil should return NULL, got %v, %v", value, err)
+	}
+	if data, err := d.MarshalJSON(); err != nil || string(data) != "null" {
+		t.Errorf("MarshalJSON on nil should return null, got %s, %v", data, err)
+	}
+	if d.String() != "" || d.ISO8601() != "" {
+		t.Error("String and ISO8601 on nil should return empty strings")
+	}
+}
+
+func TestDuration_String(t *testing.T) {
+	start := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		end      *Zeit
+		name     string
+		expected string
+	}{
+		{name: "Days and hours", end: start.Add(14*24*time.Hour + 3*time.Hour), expected: "14d 3h"},
+		{name: "All units", end: start.Add(26*time.Hour + 30*time.Minute + 15*time.Second), expected: "1d 2h 30m 15s"},
+		{name: "Drops sub-second", end: start.Add(90*time.Second + 500*time.Millisecond), expected: "1m 30s"},
+		{name: "Reversed", end: start.Add(-2 * time.Hour), expected: "-2h"},
+		{name: "Under one second", end: start.Add(time.Millisecond), expected: "0s"},
+		{name: "Zero", end: start, expected: "0s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := start.Until(tt.end).String(); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDuration_ISO8601(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		start    *Zeit
+		end      *Zeit
+		name     string
+		expected string
+	}{
+		{
+			name:     "Calendar month",
+			start:    start,
+			end:      New(time.Date(2024, 2, 15, 10, 0, 0, 0, time.UTC), time.UTC),
+			expected: "P1M",
+		},
+		{
+			name:     "Days and clock",
+			start:    start,
+			end:      start.Add(26*time.Hour + 30*time.Minute),
+			expected: "P1DT2H30M",
+		},
+		{
+			name:     "Past a clamped month end",
+			start:    New(time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC), time.UTC),
+			end:      New(time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC), time.UTC),
+			expected: "P1M2D",
+		},
+		{
+			name:     "Reversed",
+			start:    start,
+			end:      New(time.Date(2023, 1, 15, 10, 0, 0, 0, time.UTC), time.UTC),
+			expected: "-P1Y",
+		},
+		{
+			name:     "Across DST in start's timezone",
+			start:    New(time.Date(2024, 3, 30, 12, 0, 0, 0, berlin), berlin),
+			end:      New(time.Date(2024, 3, 31, 12, 0, 0, 0, berlin), berlin),
+			expected: "P1D",
+		},
+		{
+			name:     "Zero",
+			start:    start,
+			end:      start,
+			expected: "PT0S",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.start.Until(tt.end).ISO8601(); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDuration_BusinessDaysIn(t *testing.T) {
+	newYork, london := settlementCalendars()
+	vacation := &Period{
+		StartsAt: New(time.Date(2024, 8, 5, 0, 0, 0, 0, time.UTC), time.UTC),
+		EndsAt:   New(time.Date(2024, 8, 17, 0, 0, 0, 0, time.UTC), time.UTC),
+	}
+	sabbatical := &Period{StartsAt: New(time.Date(2031, 3, 1, 0, 0, 0, 0, time.UTC), time.UTC)}
+
+	calendars := map[string]*Calendar{
+		"nil":          nil,
+		"New York":     newYork,
+		"Intersect":    newYork.Intersect(london),
+		"Union":        newYork.Union(london),
+		"Absences":     london.WithAbsences(vacation, sabbatical),
+		"Nested":       newYork.WithAbsences(vacation).Intersect(london.Union(newYork)),
+		"Leap holiday": NewCalendar(Holiday{Name: "Leap Day", Month: time.February, Day: 29}),
+	}
+
+	ranges := [][2]time.Time{
+		{time.Date(2024, 7, 3, 15, 0, 0, 0, time.UTC), time.Date(2024, 7, 9, 9, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2023, 12, 24, 0, 0, 0, 0, time.UTC), time.Date(2033, 12, 27, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 8, 10, 0, 0, 0, 0, time.UTC), time.Date(2024, 8, 6, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 12, 25, 8, 0, 0, 0, time.UTC), time.Date(2024, 12, 25, 18, 0, 0, 0, time.UTC)},
+	}
+
+	for name, cal := range calendars {
+		t.Run(name, func(t *testing.T) {
+			for _, r := range ranges {
+				d := New(r[0], time.UTC).Until(New(r[1], time.UTC))
+
+				expected := 0
+				start, end := d.dates()
+				for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+					if cal.isBusinessDay(day) {
+						expected++
+					}
+				}
+
+				if result := d.BusinessDaysIn(cal); result != expected {
+					t.Errorf("%v to %v: expected %d business days, got %d", r[0], r[1], expected, result)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDuration_BusinessDays_TenYears(b *testing.B) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	d := start.Until(New(time.Date(2034, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_ = d.BusinessDays()
+	}
+}
+
+func BenchmarkDuration_BusinessDaysIn_TenYears(b *testing.B) {
+	newYork, london := settlementCalendars()
+	cal := newYork.Intersect(london)
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	d := start.Until(New(time.Date(2034, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_ = d.BusinessDaysIn(cal)
+	}
+}
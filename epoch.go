@@ -0,0 +1,283 @@
+package zeit
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EpochUnit selects how FromUserOrEpoch reads a numeric timestamp.
+type EpochUnit int
+
+const (
+	// EpochAuto guesses the unit from the number of digits: up to 11 digits are
+	// seconds (until the year 5138), 12 or more are milliseconds (from 1973 on).
+	// Seven digits without a sign are an ISO 8601 ordinal date instead.
+	EpochAuto EpochUnit = iota
+	// EpochSeconds reads Unix seconds.
+	EpochSeconds
+	// EpochMillis reads Unix milliseconds.
+	EpochMillis
+)
+
+// ArrowUnit is the resolution of an Arrow or Parquet timestamp column. The values
+// match arrow.TimeUnit, so zeit.ArrowUnit(arrow.Microsecond) converts directly.
+type ArrowUnit int
+
+const (
+	// ArrowSecond counts seconds since the Unix epoch.
+	ArrowSecond ArrowUnit = iota
+	// ArrowMillisecond counts milliseconds since the Unix epoch.
+	ArrowMillisecond
+	// ArrowMicrosecond counts microseconds since the Unix epoch.
+	ArrowMicrosecond
+	// ArrowNanosecond counts nanoseconds since the Unix epoch.
+	ArrowNanosecond
+)
+
+// perSecond returns how many ticks of the unit make up one second, or 0 for an unknown unit.
+func (u ArrowUnit) perSecond() int64 {
+	switch u {
+	case ArrowSecond:
+		return 1
+	case ArrowMillisecond:
+		return 1e3
+	case ArrowMicrosecond:
+		return 1e6
+	case ArrowNanosecond:
+		return 1e9
+	default:
+		return 0
+	}
+}
+
+// kafkaNoTimestamp is the timestamp Kafka records carry when none was set.
+const kafkaNoTimestamp = -1
+
+// kafkaMinMillis and kafkaMaxMillis bound plausible Kafka timestamps in milliseconds,
+// from 1973 to 5138 like EpochAuto. Seconds fall below and microseconds above.
+const (
+	kafkaMinMillis = 1e11
+	kafkaMaxMillis = 1e14
+)
+
+// epochAutoMaxSecondsDigits is the longest digit count EpochAuto reads as seconds.
+const epochAutoMaxSecondsDigits = 11
+
+// julianDayUnixEpoch is the Julian Day of 1970-01-01T00:00:00Z.
+const julianDayUnixEpoch = 2440587.5
+
+// excelEpoch is day zero of spreadsheet serial dates (1900 date system).
+var excelEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+// FromUserOrEpoch parses s like FromUser, or, if it is an integer with an optional
+// leading minus, as a Unix timestamp in unit. Query parameters often arrive in either
+// form, e.g. "2024-01-15T10:30:00Z", "1705314600" or "1705314600000". Use an
+// explicit unit where the digit-count guess of EpochAuto could be wrong.
+// Under EpochAuto, seven digits are an ordinal date like "2024046" rather than
+// seconds in early 1970; an explicit unit always reads them as a timestamp.
+// Timestamps are checked against the valid range like FromUser.
+// Returns ErrUnknownUnit for units other than the EpochUnit constants.
+func FromUserOrEpoch(s string, loc *time.Location, unit EpochUnit) (*Zeit, error) {
+	digits := strings.TrimPrefix(s, "-")
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return FromUser(s, loc)
+	}
+	if unit == EpochAuto && isOrdinalDate(s) {
+		return FromUser(s, loc)
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: epoch timestamp %q", ErrOutOfRange, s)
+	}
+
+	if unit == EpochAuto {
+		unit = EpochSeconds
+		if len(digits) > epochAutoMaxSecondsDigits {
+			unit = EpochMillis
+		}
+	}
+
+	var z *Zeit
+	switch unit {
+	case EpochSeconds:
+		z = FromDatabase(n, loc)
+	case EpochMillis:
+		z = New(time.UnixMilli(n), loc)
+	default:
+		return nil, fmt.Errorf("%w: epoch unit %d", ErrUnknownUnit, int(unit))
+	}
+
+	if err := z.Validate(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// EpochFloat returns the Unix timestamp in seconds with the fraction of a second,
+// as used by Prometheus and other metrics APIs. Independent of the Zeit's timezone.
+// float64 resolves current dates to about a quarter of a microsecond.
+func (z *Zeit) EpochFloat() float64 {
+	return float64(z.instant.Unix()) + float64(z.instant.Nanosecond())/float64(time.Second)
+}
+
+// FromEpochFloat creates a Zeit from Unix seconds with a fractional part, rounded
+// to the microsecond since float64 can't represent finer steps at current dates.
+// Returns ErrInvalidFormat for NaN and infinities; the result is checked against
+// the valid range like FromUser.
+func FromEpochFloat(seconds float64, loc *time.Location) (*Zeit, error) {
+	if math.IsNaN(seconds) || math.IsInf(seconds, 0) {
+		return nil, fmt.Errorf("%w: epoch seconds %v", ErrInvalidFormat, seconds)
+	}
+	if math.Abs(seconds) >= 1<<62 {
+		return nil, fmt.Errorf("%w: epoch seconds %v", ErrOutOfRange, seconds)
+	}
+
+	whole := math.Floor(seconds)
+	micros := math.Round((seconds - whole) * float64(time.Second/time.Microsecond))
+	z := New(time.Unix(int64(whole), int64(micros)*int64(time.Microsecond)), loc)
+	if err := z.Validate(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// FromKafkaTimestamp creates a Zeit in loc from the timestamp of a Kafka record,
+// in milliseconds since the Unix epoch. Returns ErrNoTimestamp for -1, which Kafka
+// uses for records without a timestamp. Values outside 1973 to 5138 are rejected
+// with ErrOutOfRange since they are almost always seconds or microseconds read as
+// milliseconds. The result is also checked against the valid range like FromUser.
+func FromKafkaTimestamp(ms int64, loc *time.Location) (*Zeit, error) {
+	if ms == kafkaNoTimestamp {
+		return nil, ErrNoTimestamp
+	}
+	if ms < kafkaMinMillis || ms >= kafkaMaxMillis {
+		return nil, fmt.Errorf("%w: kafka timestamp %d is not in milliseconds", ErrOutOfRange, ms)
+	}
+
+	z := New(time.UnixMilli(ms), loc)
+	if err := z.Validate(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// JulianDay returns the astronomical Julian Day of the instant, counted in days
+// from noon UTC on January 1, 4713 BC. Independent of the Zeit's timezone.
+func (z *Zeit) JulianDay() float64 {
+	return z.EpochFloat()/86400 + julianDayUnixEpoch
+}
+
+// FromJulianDay creates a Zeit from an astronomical Julian Day, rounded to the
+// millisecond since float64 can't represent finer steps at current dates.
+// Returns ErrInvalidFormat for NaN and infinities; the result is checked against
+// the valid range like FromUser.
+func FromJulianDay(jd float64, loc *time.Location) (*Zeit, error) {
+	if math.IsNaN(jd) || math.IsInf(jd, 0) {
+		return nil, fmt.Errorf("%w: julian day %v", ErrInvalidFormat, jd)
+	}
+	ms := math.Round((jd - julianDayUnixEpoch) * float64(24*time.Hour/time.Millisecond))
+	if math.Abs(ms) >= 1<<62 {
+		return nil, fmt.Errorf("%w: julian day %v", ErrOutOfRange, jd)
+	}
+
+	z := New(time.UnixMilli(int64(ms)), loc)
+	if err := z.Validate(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// ExcelSerial returns the spreadsheet serial date of the Zeit's local wall-clock time:
+// days since 1899-12-30 with the time of day as fraction. Spreadsheet dates carry no
+// timezone, so the same instant yields different serials in different zones.
+// Matches Excel's 1900 date system from March 1, 1900 on; Excel's fictitious
+// Feb 29, 1900 makes earlier serials differ by one day.
+func (z *Zeit) ExcelSerial() float64 {
+	local := z.Time()
+	date := civilDate(local)
+	days := (date.Unix() - excelEpoch.Unix()) / 86400
+	wall := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute +
+		time.Duration(local.Second())*time.Second + time.Duration(local.Nanosecond())
+	return float64(days) + float64(wall)/float64(24*time.Hour)
+}
+
+// FromExcelSerial creates a Zeit from a spreadsheet serial date, interpreting the
+// wall-clock time in loc. The time of day is rounded to the millisecond.
+// Returns ErrInvalidFormat for NaN and infinities; the result is checked against
+// the valid range like FromUser.
+func FromExcelSerial(serial float64, loc *time.Location) (*Zeit, error) {
+	if math.IsNaN(serial) || math.IsInf(serial, 0) {
+		return nil, fmt.Errorf("%w: spreadsheet serial %v", ErrInvalidFormat, serial)
+	}
+	if math.Abs(serial) >= 1<<40 {
+		return nil, fmt.Errorf("%w: spreadsheet serial %v", ErrOutOfRange, serial)
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	days := math.Floor(serial)
+	ms := math.Round((serial - days) * float64(24*time.Hour/time.Millisecond))
+	t := time.Date(1899, time.December, 30+int(days), 0, 0, 0, int(ms)*int(time.Millisecond), loc)
+	z := New(t, loc)
+	if err := z.Validate(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// EpochDay returns the number of days between 1970-01-01 and the Zeit's local
+// calendar date. Negative for dates before 1970. Useful as a compact date key.
+func (z *Zeit) EpochDay() int64 {
+	return civilDate(z.Time()).Unix() / 86400
+}
+
+// FromEpochDay creates a Zeit at local midnight of the date that is days after
+// 1970-01-01 in loc.
+func FromEpochDay(days int64, loc *time.Location) *Zeit {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return New(time.Date(1970, time.January, 1+int(days), 0, 0, 0, 0, loc), loc)
+}
+
+// ToArrowTimestamp returns the instant as ticks of unit since the Unix epoch, the
+// physical value of an Arrow or Parquet timestamp column. Sub-unit precision is
+// truncated towards the past, so 1969-12-31T23:59:59.5Z is -1 in seconds.
+// Arrow timestamps carry at most a zone name, so the Zeit's timezone is not part
+// of the value. Returns ErrOutOfRange if the value doesn't fit in an int64, as
+// for nanoseconds outside the years 1677 to 2262, and ErrUnknownUnit for units
+// other than the ArrowUnit constants.
+func (z *Zeit) ToArrowTimestamp(unit ArrowUnit) (int64, error) {
+	perSecond := unit.perSecond()
+	if perSecond == 0 {
+		return 0, fmt.Errorf("%w: arrow unit %d", ErrUnknownUnit, int(unit))
+	}
+
+	sec := z.instant.Unix()
+	if sec <= math.MinInt64/perSecond || sec >= math.MaxInt64/perSecond {
+		return 0, fmt.Errorf("%w: %s in arrow unit %d", ErrOutOfRange, z.ToUser(), int(unit))
+	}
+	return sec*perSecond + int64(z.instant.Nanosecond())/(1e9/perSecond), nil
+}
+
+// FromArrowTimestamp creates a Zeit in loc from ticks of unit since the Unix epoch,
+// as read from an Arrow or Parquet timestamp column. The result is checked against
+// the valid range like FromUser. A nil loc defaults to UTC.
+// Returns ErrUnknownUnit for units other than the ArrowUnit constants.
+func FromArrowTimestamp(v int64, unit ArrowUnit, loc *time.Location) (*Zeit, error) {
+	perSecond := unit.perSecond()
+	if perSecond == 0 {
+		return nil, fmt.Errorf("%w: arrow unit %d", ErrUnknownUnit, int(unit))
+	}
+
+	z := New(time.Unix(v/perSecond, v%perSecond*(1e9/perSecond)), loc)
+	if err := z.Validate(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
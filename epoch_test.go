@@ -0,0 +1,459 @@
+package zeit
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestJulianDay(t *testing.T) {
+	tests := []struct {
+		time     time.Time
+		name     string
+		expected float64
+	}{
+		{time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC), "J2000 epoch", 2451545.0},
+		{time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), "Unix epoch", 2440587.5},
+		{time.Date(2024, 1, 15, 18, 0, 0, 0, time.UTC), "Evening", 2460325.25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			berlin, _ := time.LoadLocation("Europe/Berlin")
+			z := New(tt.time, berlin)
+			if got := z.JulianDay(); math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("Expected %f, got %f", tt.expected, got)
+			}
+
+			back, err := FromJulianDay(tt.expected, berlin)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !back.instant.Equal(tt.time) {
+				t.Errorf("FromJulianDay: expected %v, got %v", tt.time, back.instant)
+			}
+			if back.Location() != berlin {
+				t.Error("FromJulianDay should use the given location")
+			}
+		})
+	}
+}
+
+func TestJulianDay_RoundTrip(t *testing.T) {
+	original := New(time.Date(2024, 7, 3, 9, 41, 27, 123000000, time.UTC), time.UTC)
+
+	restored, err := FromJulianDay(original.JulianDay(), time.UTC)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !restored.Equal(original) {
+		t.Errorf("Expected %v, got %v", original.instant, restored.instant)
+	}
+}
+
+func TestEpochFloat(t *testing.T) {
+	tests := []struct {
+		time     time.Time
+		name     string
+		expected float64
+	}{
+		{time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), "Unix epoch", 0},
+		{time.Date(2024, 1, 15, 10, 30, 0, 250000000, time.UTC), "Quarter second", 1705314600.25},
+		{time.Date(2024, 1, 15, 10, 30, 0, 123456000, time.UTC), "Microseconds", 1705314600.123456},
+		{time.Date(1969, 12, 31, 23, 59, 59, 500000000, time.UTC), "Before epoch", -0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			berlin, _ := time.LoadLocation("Europe/Berlin")
+			z := New(tt.time, berlin)
+			if got := z.EpochFloat(); math.Abs(got-tt.expected) > 1e-6 {
+				t.Errorf("Expected %f, got %f", tt.expected, got)
+			}
+
+			back, err := FromEpochFloat(tt.expected, berlin)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !back.instant.Equal(tt.time) {
+				t.Errorf("FromEpochFloat: expected %v, got %v", tt.time, back.instant)
+			}
+			if back.Location() != berlin {
+				t.Error("FromEpochFloat should use the given location")
+			}
+		})
+	}
+}
+
+func TestEpochFloat_RoundTrip(t *testing.T) {
+	original := New(time.Date(2024, 7, 3, 9, 41, 27, 123456789, time.UTC), time.UTC)
+
+	restored, err := FromEpochFloat(original.EpochFloat(), time.UTC)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if expected := original.instant.Round(time.Microsecond); !restored.instant.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, restored.instant)
+	}
+}
+
+func TestFromEpochFloat_Invalid(t *testing.T) {
+	tests := []struct {
+		err     error
+		name    string
+		seconds float64
+	}{
+		{ErrInvalidFormat, "NaN", math.NaN()},
+		{ErrInvalidFormat, "Positive infinity", math.Inf(1)},
+		{ErrInvalidFormat, "Negative infinity", math.Inf(-1)},
+		{ErrOutOfRange, "Beyond int64", 1e300},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := FromEpochFloat(tt.seconds, time.UTC); !errors.Is(err, tt.err) {
+				t.Errorf("Expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestFromEpochFloat_ValidRange(t *testing.T) {
+	earliest, latest := ingestionRange()
+	withValidRange(t, earliest, latest)
+
+	if _, err := FromEpochFloat(-1e10, time.UTC); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Expected ErrOutOfRange, got %v", err)
+	}
+	if _, err := FromEpochFloat(1705314600.25, time.UTC); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestFromKafkaTimestamp(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	z, err := FromKafkaTimestamp(1705314600123, berlin)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := time.Date(2024, 1, 15, 10, 30, 0, 123000000, time.UTC)
+	if !z.instant.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, z.instant)
+	}
+	if z.Location() != berlin {
+		t.Error("Result should be in the given location")
+	}
+}
+
+func TestFromKafkaTimestamp_Invalid(t *testing.T) {
+	tests := []struct {
+		target error
+		name   string
+		ms     int64
+	}{
+		{name: "No timestamp", ms: -1, target: ErrNoTimestamp},
+		{name: "Negative", ms: -86400000, target: ErrOutOfRange},
+		{name: "Zero", ms: 0, target: ErrOutOfRange},
+		{name: "Seconds", ms: 1705314600, target: ErrOutOfRange},
+		{name: "Microseconds", ms: 1705314600123456, target: ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := FromKafkaTimestamp(tt.ms, time.UTC); !errors.Is(err, tt.target) {
+				t.Errorf("Expected %v, got %v", tt.target, err)
+			}
+		})
+	}
+}
+
+func TestFromKafkaTimestamp_ValidRange(t *testing.T) {
+	withValidRange(t, New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC), nil)
+
+	if _, err := FromKafkaTimestamp(1705314600123, time.UTC); err != nil {
+		t.Errorf("Unexpected error in range: %v", err)
+	}
+	if _, err := FromKafkaTimestamp(1500000000000, time.UTC); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Expected ErrOutOfRange, got %v", err)
+	}
+}
+
+func TestExcelSerial(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		local    time.Time
+		name     string
+		expected float64
+	}{
+		{time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC), "First unambiguous day", 61},
+		{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), "Date", 45306},
+		{time.Date(2024, 1, 15, 18, 0, 0, 0, time.UTC), "Date and time", 45306.75},
+		{time.Date(2024, 1, 15, 6, 0, 0, 0, berlin), "Local wall clock", 45306.25},
+		{time.Date(2024, 3, 31, 12, 0, 0, 0, berlin), "DST day", 45382.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := tt.local.Location()
+			z := New(tt.local, loc)
+			if got := z.ExcelSerial(); math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("Expected %f, got %f", tt.expected, got)
+			}
+
+			back, err := FromExcelSerial(tt.expected, loc)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !back.Time().Equal(tt.local) {
+				t.Errorf("FromExcelSerial: expected %v, got %v", tt.local, back.Time())
+			}
+		})
+	}
+}
+
+func TestFromExcelSerial_Rounding(t *testing.T) {
+	// 10:30:00 is 0.4375 of a day; float noise must not leak into the result
+	z, err := FromExcelSerial(45306.4375+1e-12, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	if !z.instant.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, z.instant)
+	}
+}
+
+func TestFromJulianDay_FromExcelSerial_Invalid(t *testing.T) {
+	tests := []struct {
+		err   error
+		name  string
+		value float64
+	}{
+		{ErrInvalidFormat, "NaN", math.NaN()},
+		{ErrInvalidFormat, "Positive infinity", math.Inf(1)},
+		{ErrInvalidFormat, "Negative infinity", math.Inf(-1)},
+		{ErrOutOfRange, "Beyond int64", 1e300},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := FromJulianDay(tt.value, time.UTC); !errors.Is(err, tt.err) {
+				t.Errorf("FromJulianDay: expected %v, got %v", tt.err, err)
+			}
+			if _, err := FromExcelSerial(tt.value, time.UTC); !errors.Is(err, tt.err) {
+				t.Errorf("FromExcelSerial: expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestFromJulianDay_FromExcelSerial_ValidRange(t *testing.T) {
+	earliest, latest := ingestionRange()
+	withValidRange(t, earliest, latest)
+
+	// Julian Day 0 and serial -1e6 both lie thousands of years before 1900
+	if _, err := FromJulianDay(0, time.UTC); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("FromJulianDay: expected ErrOutOfRange, got %v", err)
+	}
+	if _, err := FromExcelSerial(-1e6, time.UTC); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("FromExcelSerial: expected ErrOutOfRange, got %v", err)
+	}
+}
+
+func TestEpochDay(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	tests := []struct {
+		time     time.Time
+		loc      *time.Location
+		name     string
+		expected int64
+	}{
+		{time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC, "Epoch", 0},
+		{time.Date(2024, 1, 15, 23, 59, 59, 0, time.UTC), time.UTC, "End of day", 19737},
+		{time.Date(1969, 12, 31, 12, 0, 0, 0, time.UTC), time.UTC, "Before epoch", -1},
+		// 20:00 UTC on Jan 15 is Jan 16 in Tokyo
+		{time.Date(2024, 1, 15, 20, 0, 0, 0, time.UTC), tokyo, "Local date", 19738},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := New(tt.time, tt.loc)
+			if got := z.EpochDay(); got != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestFromEpochDay(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	z := FromEpochDay(19737, berlin)
+
+	expected := time.Date(2024, 1, 15, 0, 0, 0, 0, berlin)
+	if !z.Time().Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, z.Time())
+	}
+	if z.EpochDay() != 19737 {
+		t.Errorf("Round trip failed: got %d", z.EpochDay())
+	}
+	if FromEpochDay(-1, nil).ToUser() != "1969-12-31T00:00:00Z" {
+		t.Errorf("Negative epoch day: got %s", FromEpochDay(-1, nil).ToUser())
+	}
+}
+
+func TestFromUserOrEpoch(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	instant := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		expected time.Time
+		input    string
+		name     string
+		unit     EpochUnit
+	}{
+		{name: "RFC3339", input: "2024-01-15T10:30:00Z", unit: EpochAuto, expected: instant},
+		{name: "Auto seconds", input: "1705314600", unit: EpochAuto, expected: instant},
+		{name: "Auto millis", input: "1705314600123", unit: EpochAuto, expected: instant.Add(123 * time.Millisecond)},
+		{name: "Explicit seconds", input: "1705314600123", unit: EpochSeconds, expected: time.Unix(1705314600123, 0)},
+		{name: "Explicit millis", input: "86400000", unit: EpochMillis, expected: time.Date(1970, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "Negative seconds", input: "-86400", unit: EpochAuto, expected: time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "Auto ordinal date", input: "2024046", unit: EpochAuto, expected: time.Date(2024, 2, 14, 23, 0, 0, 0, time.UTC)},
+		{name: "Explicit seconds, seven digits", input: "2024046", unit: EpochSeconds, expected: time.Unix(2024046, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z, err := FromUserOrEpoch(tt.input, berlin, tt.unit)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !z.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, z.instant)
+			}
+			if z.Location() != berlin {
+				t.Error("Result should be in the given location")
+			}
+		})
+	}
+}
+
+func TestFromUserOrEpoch_Invalid(t *testing.T) {
+	tests := []struct {
+		target error
+		input  string
+		name   string
+		unit   EpochUnit
+	}{
+		{name: "Garbage", input: "yesterday", target: ErrInvalidFormat},
+		{name: "Lone minus", input: "-", target: ErrInvalidFormat},
+		{name: "Empty", input: "", target: ErrInvalidFormat},
+		{name: "Overflows int64", input: "99999999999999999999", target: ErrOutOfRange},
+		{name: "Unknown unit", input: "1705314600", unit: EpochUnit(9), target: ErrUnknownUnit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FromUserOrEpoch(tt.input, time.UTC, tt.unit)
+			if err == nil {
+				t.Fatal("Expected error")
+			}
+			if tt.target != nil && !errors.Is(err, tt.target) {
+				t.Errorf("Expected %v, got %v", tt.target, err)
+			}
+		})
+	}
+}
+
+func TestFromUserOrEpoch_ValidRange(t *testing.T) {
+	earliest, latest := ingestionRange()
+	withValidRange(t, earliest, latest)
+
+	if _, err := FromUserOrEpoch("0", time.UTC, EpochAuto); err != nil {
+		t.Errorf("Unexpected error for epoch 0 in range: %v", err)
+	}
+	if _, err := FromUserOrEpoch("99999999999", time.UTC, EpochSeconds); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Expected ErrOutOfRange, got %v", err)
+	}
+}
+
+func TestArrowTimestamp(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	instant := time.Date(2024, 1, 15, 10, 30, 0, 123456789, time.UTC)
+	beforeEpoch := time.Date(1969, 12, 31, 23, 59, 59, 500000000, time.UTC)
+
+	tests := []struct {
+		instant  time.Time
+		name     string
+		unit     ArrowUnit
+		expected int64
+	}{
+		{name: "Seconds", instant: instant, unit: ArrowSecond, expected: 1705314600},
+		{name: "Millis", instant: instant, unit: ArrowMillisecond, expected: 1705314600123},
+		{name: "Micros", instant: instant, unit: ArrowMicrosecond, expected: 1705314600123456},
+		{name: "Nanos", instant: instant, unit: ArrowNanosecond, expected: 1705314600123456789},
+		{name: "Seconds before epoch", instant: beforeEpoch, unit: ArrowSecond, expected: -1},
+		{name: "Millis before epoch", instant: beforeEpoch, unit: ArrowMillisecond, expected: -500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := New(tt.instant, berlin).ToArrowTimestamp(tt.unit)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if v != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, v)
+			}
+
+			z, err := FromArrowTimestamp(v, tt.unit, berlin)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if expected := tt.instant.Truncate(time.Second / time.Duration(tt.unit.perSecond())); !z.instant.Equal(expected) {
+				t.Errorf("Expected %v, got %v", expected, z.instant)
+			}
+			if z.Location() != berlin {
+				t.Error("Result should be in the given location")
+			}
+		})
+	}
+}
+
+func TestArrowTimestamp_Invalid(t *testing.T) {
+	z := New(time.Date(2300, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	if _, err := z.ToArrowTimestamp(ArrowNanosecond); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Expected %v, got %v", ErrOutOfRange, err)
+	}
+	if _, err := z.ToArrowTimestamp(ArrowMicrosecond); err != nil {
+		t.Errorf("Unexpected error for micros: %v", err)
+	}
+	if _, err := z.ToArrowTimestamp(ArrowUnit(7)); !errors.Is(err, ErrUnknownUnit) {
+		t.Errorf("Expected %v, got %v", ErrUnknownUnit, err)
+	}
+	if _, err := FromArrowTimestamp(0, ArrowUnit(-1), time.UTC); !errors.Is(err, ErrUnknownUnit) {
+		t.Errorf("Expected %v, got %v", ErrUnknownUnit, err)
+	}
+	if z, err := FromArrowTimestamp(math.MinInt64, ArrowNanosecond, nil); err != nil || z.instant.Year() != 1677 {
+		t.Errorf("Expected 1677, got %v (%v)", z, err)
+	}
+}
+
+func TestFromArrowTimestamp_ValidRange(t *testing.T) {
+	earliest, latest := ingestionRange()
+	withValidRange(t, earliest, latest)
+
+	if _, err := FromArrowTimestamp(0, ArrowMicrosecond, time.UTC); err != nil {
+		t.Errorf("Unexpected error for epoch 0 in range: %v", err)
+	}
+	if _, err := FromArrowTimestamp(99999999999, ArrowSecond, time.UTC); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Expected ErrOutOfRange, got %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+package zeit
+
+import "errors"
+
+// ErrOutOfRange is returned when a result falls outside the representable or
+// configured range of times.
+var ErrOutOfRange = errors.New("zeit: time out of range")
+
+// ErrInvalidFormat is returned when a string cannot be parsed: timestamps,
+// ISO 8601 durations and intervals, dates, times of day and interval names.
+var ErrInvalidFormat = errors.New("zeit: invalid format")
+
+// ErrAmbiguousDate is returned by ParseNumericDate when a date could be read in
+// more than one way, such as "01/02/2024" without a DateOrder or a two-digit year.
+var ErrAmbiguousDate = errors.New("zeit: ambiguous date")
+
+// ErrUnknownUnit is returned when a unit argument, such as an EpochUnit, is not
+// one of the defined constants.
+var ErrUnknownUnit = errors.New("zeit: unknown unit")
+
+// ErrNoTimestamp is returned by FromKafkaTimestamp for records without a
+// timestamp, which Kafka marks with -1.
+var ErrNoTimestamp = errors.New("zeit: no timestamp")
+
+// ErrNoBusinessDay is returned by business-day math that finds no business day
+// within ten years, as behind an open-ended absence.
+var ErrNoBusinessDay = errors.New("zeit: no business day")
+
+// ErrNilValue is returned when scanning a SQL NULL into a non-nullable value.
+// Scan into a **Zeit or use sql.Null[*Zeit] for nullable columns.
+var ErrNilValue = errors.New("zeit: nil value")
+
+// ErrUnsupportedScanType is returned when Scan receives a type it cannot convert.
+var ErrUnsupportedScanType = errors.New("zeit: unsupported scan type")
+
+// ErrBrokenChain is returned by ValidateChain, wrapped in a *ChainError that
+// points at the offending period.
+var ErrBrokenChain = errors.New("zeit: broken period chain")
+
+// ErrUnknownTimezone is returned by LoadLocation for unknown timezone names.
+var ErrUnknownTimezone = errors.New("zeit: unknown timezone")
@@ -0,0 +1,129 @@
+package zeit
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	var z Zeit
+	var d Duration
+	var date DateJSON
+	var dateTime DateTimeJSON
+	var kind PeriodKind
+
+	tests := []struct {
+		err    error
+		target error
+		name   string
+	}{
+		{errOf(FromUser("not a time", time.UTC)), ErrInvalidFormat, "FromUser garbage"},
+		{errOf(FromUser("2024-W60-1", time.UTC)), ErrInvalidFormat, "FromUser week date"},
+		{errOf(FromUser("2023-366", time.UTC)), ErrInvalidFormat, "FromUser ordinal date"},
+		{errOf(ParsePeriod("2024-01-01", time.UTC)), ErrInvalidFormat, "ParsePeriod"},
+		{errOf(ParsePeriod("2024-01-01/P1X", time.UTC)), ErrInvalidFormat, "ParsePeriod duration"},
+		{errOf(ParsePeriod("2024-02-01/2024-01-01", time.UTC)), ErrInvalidFormat, "ParsePeriod reversed"},
+		{errOf(ParseInterval("sometimes")), ErrInvalidFormat, "ParseInterval"},
+		{errOf(FromDateTimeLocal("2024-01-15", time.UTC)), ErrInvalidFormat, "FromDateTimeLocal"},
+		{errOf(FromDateTimeLocal("2024-01-15T10:30", nil)), ErrUnknownTimezone, "FromDateTimeLocal without location"},
+		{errOf(ParseNumericDate("31/02/2024", DMY, time.UTC)), ErrInvalidFormat, "ParseNumericDate"},
+		{errOf(ParseNumericDate("15/01/2024", DateOrder(9), time.UTC)), ErrInvalidFormat, "ParseNumericDate order"},
+		{errOf(ParseNumericDate("01/02/2024", DateOrderUnknown, time.UTC)), ErrAmbiguousDate, "ParseNumericDate ambiguous"},
+		{errOf(ParseLocalized("15 Januar 2024", English, time.UTC)), ErrInvalidFormat, "ParseLocalized"},
+		{errOf(ParseICS("BEGIN:VEVENT\nEND:VEVENT\n", time.UTC)), ErrInvalidFormat, "ParseICS"},
+		{errOf(NewCSVColumn(time.DateOnly, time.UTC).Unmarshal("15.01.2024")), ErrInvalidFormat, "CSVColumn"},
+		{errOf(FromNumericDate("soon", time.UTC)), ErrInvalidFormat, "FromNumericDate"},
+		{errOf(ParseRetryAfter("soon", nil)), ErrInvalidFormat, "ParseRetryAfter"},
+		{json.Unmarshal([]byte(`"15.01.2024"`), &date), ErrInvalidFormat, "DateJSON"},
+		{json.Unmarshal([]byte(`"2024-01-15"`), &dateTime), ErrInvalidFormat, "DateTimeJSON"},
+		{json.Unmarshal([]byte(`"1/15/2024"`), &z), ErrInvalidFormat, "Zeit JSON"},
+		{z.UnmarshalGQL(42), ErrInvalidFormat, "UnmarshalGQL type"},
+		{kind.UnmarshalText([]byte("bonus")), ErrInvalidFormat, "PeriodKind"},
+		{z.Scan(nil), ErrNilValue, "Zeit Scan nil"},
+		{d.Scan(nil), ErrNilValue, "Duration Scan nil"},
+		{z.Scan("2024-01-15"), ErrUnsupportedScanType, "Zeit Scan string"},
+		{d.Scan([]byte("60")), ErrUnsupportedScanType, "Duration Scan bytes"},
+		{errOf(FromUserOrEpoch("1705314600", time.UTC, EpochUnit(9))), ErrUnknownUnit, "FromUserOrEpoch unit"},
+		{errOf(FromEpochFloat(math.NaN(), time.UTC)), ErrInvalidFormat, "FromEpochFloat"},
+		{errOf(FromJulianDay(math.Inf(1), time.UTC)), ErrInvalidFormat, "FromJulianDay"},
+		{errOf(FromExcelSerial(math.NaN(), time.UTC)), ErrInvalidFormat, "FromExcelSerial"},
+		{errOf(NewContract(Now(time.UTC), nil, Monthly, nil).CancelAt(Now(time.UTC).AddDays(-1), CancelImmediately)), ErrOutOfRange, "Contract.CancelAt"},
+		{SetValidRange(Now(time.UTC), Now(time.UTC).AddDays(-1)), ErrOutOfRange, "SetValidRange reversed"},
+		{errOf(FromKafkaTimestamp(-1, time.UTC)), ErrNoTimestamp, "FromKafkaTimestamp"},
+		{errOf(NewCalendar().WithAbsences(&Period{StartsAt: Now(time.UTC)}).AddBusinessDays(Now(time.UTC), 1)), ErrNoBusinessDay, "Calendar.AddBusinessDays"},
+		{errOf(LoadLocation("Mars/Olympus_Mons")), ErrUnknownTimezone, "LoadLocation"},
+		{errOf(ResolveAbbreviation("XYZ", "")), ErrUnknownTimezone, "ResolveAbbreviation"},
+		{errOf(FromWindowsZone("Mars Standard Time")), ErrUnknownTimezone, "FromWindowsZone"},
+		{ValidateChain([]*Period{nil}), ErrBrokenChain, "ValidateChain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.target) {
+				t.Errorf("Expected %v, got %v", tt.target, tt.err)
+			}
+		})
+	}
+}
+
+func TestLoadLocation(t *testing.T) {
+	loc, err := LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("LoadLocation error: %v", err)
+	}
+	if loc.String() != "Europe/Berlin" {
+		t.Errorf("Expected Europe/Berlin, got %s", loc)
+	}
+}
+
+func TestMustLocation(t *testing.T) {
+	if loc := MustLocation("Asia/Tokyo"); loc.String() != "Asia/Tokyo" {
+		t.Errorf("Expected Asia/Tokyo, got %s", loc)
+	}
+
+	err := recoverError(func() { MustLocation("Mars/Olympus_Mons") })
+	if !errors.Is(err, ErrUnknownTimezone) {
+		t.Errorf("Expected panic with %v, got %v", ErrUnknownTimezone, err)
+	}
+}
+
+func TestMustFromUser(t *testing.T) {
+	z := MustFromUser("2024-01-15T10:30:00Z", time.UTC)
+	expected := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	if !z.instant.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, z.instant)
+	}
+
+	err := recoverError(func() { MustFromUser("not a time", time.UTC) })
+	if !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Expected panic with %v, got %v", ErrInvalidFormat, err)
+	}
+}
+
+func TestFromUser_KeepsParseError(t *testing.T) {
+	_, err := FromUser("2024-13-45T00:00:00Z", time.UTC)
+
+	var parseErr *time.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Errorf("Expected wrapped *time.ParseError, got %v", err)
+	}
+}
+
+// errOf drops the value of a (value, error) pair.
+func errOf[T any](_ T, err error) error {
+	return err
+}
+
+// recoverError runs fn and returns the error it panics with, or nil if it doesn't panic.
+func recoverError(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, _ = r.(error)
+		}
+	}()
+	fn()
+	return nil
+}
@@ -0,0 +1,412 @@
+package zeit
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Locale holds the names and markers used by FormatLocalized.
+// Weekdays are indexed by time.Weekday, so Sunday comes first.
+type Locale struct {
+	Ordinal       func(day int) string
+	AM            string
+	PM            string
+	Months        [12]string
+	ShortMonths   [12]string
+	Weekdays      [7]string
+	ShortWeekdays [7]string
+}
+
+// English formats ordinals as "1st", "2nd", "3rd", "4th" and uses AM/PM.
+var English = &Locale{
+	Ordinal: func(day int) string {
+		suffix := "th"
+		if day%100 < 11 || day%100 > 13 {
+			switch day % 10 {
+			case 1:
+				suffix = "st"
+			case 2:
+				suffix = "nd"
+			case 3:
+				suffix = "rd"
+			}
+		}
+		return strconv.Itoa(day) + suffix
+	},
+	AM:            "AM",
+	PM:            "PM",
+	Months:        [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	ShortMonths:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	Weekdays:      [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	ShortWeekdays: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+}
+
+// German formats ordinals as "1." and uses "vorm."/"nachm." as AM/PM markers.
+var German = &Locale{
+	Ordinal: func(day int) string {
+		return strconv.Itoa(day) + "."
+	},
+	AM:            "vorm.",
+	PM:            "nachm.",
+	Months:        [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	ShortMonths:   [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+	Weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	ShortWeekdays: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+}
+
+// localizedTokens are the layout tokens FormatLocalized handles itself,
+// longest first so "January" wins over "Jan".
+var localizedTokens = []string{"January", "Monday", "Jan", "Mon", "2nd", "PM", "pm"}
+
+// FormatLocalized formats the Zeit like Format, in the Zeit's timezone, with
+// month names, weekday names and AM/PM markers taken from locale. It also
+// understands the ordinal day token "2nd", which Go layouts can't express:
+//
+//	z.FormatLocalized("January 2nd, 2006 3:04 PM", zeit.English)  // "January 15th, 2024 2:30 PM"
+//
+// A nil locale uses English. A nil Zeit returns an empty string.
+func (z *Zeit) FormatLocalized(layout string, locale *Locale) string {
+	if z == nil {
+		return ""
+	}
+	if locale == nil {
+		locale = English
+	}
+
+	t := z.Time()
+	var b strings.Builder
+	chunkStart := 0
+
+	for i := 0; i < len(layout); {
+		token := ""
+		for _, candidate := range localizedTokens {
+			if strings.HasPrefix(layout[i:], candidate) {
+				token = candidate
+				break
+			}
+		}
+		if token == "" {
+			i++
+			continue
+		}
+
+		b.WriteString(t.Format(layout[chunkStart:i]))
+		switch token {
+		case "January":
+			b.WriteString(locale.Months[t.Month()-1])
+		case "Jan":
+			b.WriteString(locale.ShortMonths[t.Month()-1])
+		case "Monday":
+			b.WriteString(locale.Weekdays[t.Weekday()])
+		case "Mon":
+			b.WriteString(locale.ShortWeekdays[t.Weekday()])
+		case "2nd":
+			b.WriteString(locale.Ordinal(t.Day()))
+		case "PM", "pm":
+			marker := locale.AM
+			if t.Hour() >= 12 {
+				marker = locale.PM
+			}
+			if token == "pm" {
+				marker = strings.ToLower(marker)
+			}
+			b.WriteString(marker)
+		}
+		i += len(token)
+		chunkStart = i
+	}
+
+	b.WriteString(t.Format(layout[chunkStart:]))
+	return b.String()
+}
+
+// Formatter formats Zeits with a layout that is parsed once, for export jobs that
+// format millions of values with the same layout. Output is identical to Format.
+// A Formatter is immutable and safe for concurrent use.
+type Formatter struct {
+	loc    *time.Location
+	layout string
+	chunks []formatChunk
+}
+
+// formatKind identifies the layout element a formatChunk writes.
+type formatKind int
+
+const (
+	formatLiteral formatKind = iota
+	formatOther              // formatted by the time package, e.g. zones and fractional seconds
+	formatLongYear
+	formatYear
+	formatLongMonth
+	formatMonth
+	formatNumMonth
+	formatZeroMonth
+	formatLongWeekday
+	formatWeekday
+	formatDay
+	formatUnderDay
+	formatZeroDay
+	formatHour
+	formatHour12
+	formatZeroHour12
+	formatMinute
+	formatZeroMinute
+	formatSecond
+	formatZeroSecond
+	formatPM
+	formatLowerPM
+)
+
+// formatChunk is one element of a compiled layout. text holds the literal for
+// formatLiteral and the layout token for formatOther.
+type formatChunk struct {
+	text string
+	kind formatKind
+}
+
+// NewFormatter compiles layout for formatting in loc. A nil loc formats each
+// Zeit in its own timezone, like Format.
+//
+//	f := zeit.NewFormatter("2006-01-02 15:04:05", berlin)
+//	for _, row := range rows {
+//		buf = f.AppendFormat(buf[:0], row.CreatedAt)
+//	}
+func NewFormatter(layout string, loc *time.Location) *Formatter {
+	return &Formatter{loc: loc, layout: layout, chunks: compileLayout(layout)}
+}
+
+// Layout returns the layout the Formatter was created with.
+func (f *Formatter) Layout() string {
+	return f.layout
+}
+
+// Format returns z formatted with the Formatter's layout. A nil Zeit returns an
+// empty string.
+func (f *Formatter) Format(z *Zeit) string {
+	if z == nil {
+		return ""
+	}
+	var buf [64]byte
+	return string(f.AppendFormat(buf[:0], z))
+}
+
+// AppendFormat is like Format but appends to dst and returns the extended buffer,
+// so reusing dst avoids allocations. A nil Zeit appends nothing.
+func (f *Formatter) AppendFormat(dst []byte, z *Zeit) []byte {
+	if z == nil {
+		return dst
+	}
+	loc := f.loc
+	if loc == nil {
+		loc = z.location
+	}
+
+	t := z.instant.In(loc)
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+
+	for _, c := range f.chunks {
+		switch c.kind {
+		case formatLiteral:
+			dst = append(dst, c.text...)
+		case formatOther:
+			dst = t.AppendFormat(dst, c.text)
+		case formatLongYear:
+			dst = appendPadded(dst, year, 4)
+		case formatYear:
+			// The time package drops the sign of negative years here.
+			dst = appendPadded(dst, max(year, -year)%100, 2)
+		case formatLongMonth:
+			dst = append(dst, month.String()...)
+		case formatMonth:
+			dst = append(dst, month.String()[:3]...)
+		case formatNumMonth:
+			dst = appendPadded(dst, int(month), 0)
+		case formatZeroMonth:
+			dst = appendPadded(dst, int(month), 2)
+		case formatLongWeekday:
+			dst = append(dst, t.Weekday().String()...)
+		case formatWeekday:
+			dst = append(dst, t.Weekday().String()[:3]...)
+		case formatDay:
+			dst = appendPadded(dst, day, 0)
+		case formatUnderDay:
+			if day < 10 {
+				dst = append(dst, ' ')
+			}
+			dst = appendPadded(dst, day, 0)
+		case formatZeroDay:
+			dst = appendPadded(dst, day, 2)
+		case formatHour:
+			dst = appendPadded(dst, hour, 2)
+		case formatHour12, formatZeroHour12:
+			h := hour % 12
+			if h == 0 {
+				h = 12
+			}
+			width := 0
+			if c.kind == formatZeroHour12 {
+				width = 2
+			}
+			dst = appendPadded(dst, h, width)
+		case formatMinute:
+			dst = appendPadded(dst, minute, 0)
+		case formatZeroMinute:
+			dst = appendPadded(dst, minute, 2)
+		case formatSecond:
+			dst = appendPadded(dst, sec, 0)
+		case formatZeroSecond:
+			dst = appendPadded(dst, sec, 2)
+		case formatPM, formatLowerPM:
+			marker := "AM"
+			if hour >= 12 {
+				marker = "PM"
+			}
+			if c.kind == formatLowerPM {
+				marker = strings.ToLower(marker)
+			}
+			dst = append(dst, marker...)
+		}
+	}
+	return dst
+}
+
+// appendPadded appends the decimal form of x, zero-padded to width digits,
+// with a leading minus sign for negative values as the time package writes it.
+func appendPadded(dst []byte, x, width int) []byte {
+	if x < 0 {
+		dst = append(dst, '-')
+		x = -x
+	}
+	var digits [20]byte
+	i := len(digits)
+	for x >= 10 {
+		i--
+		digits[i] = byte('0' + x%10)
+		x /= 10
+	}
+	i--
+	digits[i] = byte('0' + x)
+	for n := len(digits) - i; n < width; n++ {
+		dst = append(dst, '0')
+	}
+	return append(dst, digits[i:]...)
+}
+
+// compileLayout splits a Go time layout into chunks, recognizing layout tokens
+// with the same rules as the time package.
+func compileLayout(layout string) []formatChunk {
+	var chunks []formatChunk
+	literal := func(s string) {
+		if s != "" {
+			chunks = append(chunks, formatChunk{text: s, kind: formatLiteral})
+		}
+	}
+
+	for layout != "" {
+		prefix, token, kind, rest := nextLayoutToken(layout)
+		literal(prefix)
+		if token == "" {
+			break
+		}
+		chunks = append(chunks, formatChunk{text: token, kind: kind})
+		layout = rest
+	}
+	return chunks
+}
+
+// nextLayoutToken finds the first layout token in layout and returns the literal
+// text before it, the token, its kind and the remaining layout. It follows the
+// token rules of the time package. token is empty if layout holds no token.
+func nextLayoutToken(layout string) (prefix, token string, kind formatKind, rest string) {
+	found := func(i, n int, kind formatKind) (string, string, formatKind, string) {
+		return layout[:i], layout[i : i+n], kind, layout[i+n:]
+	}
+
+	for i := 0; i < len(layout); i++ {
+		switch c := layout[i]; c {
+		case 'J':
+			if strings.HasPrefix(layout[i:], "January") {
+				return found(i, 7, formatLongMonth)
+			}
+			if strings.HasPrefix(layout[i:], "Jan") && !startsWithLower(layout[i+3:]) {
+				return found(i, 3, formatMonth)
+			}
+		case 'M':
+			if strings.HasPrefix(layout[i:], "Monday") {
+				return found(i, 6, formatLongWeekday)
+			}
+			if strings.HasPrefix(layout[i:], "Mon") && !startsWithLower(layout[i+3:]) {
+				return found(i, 3, formatWeekday)
+			}
+			if strings.HasPrefix(layout[i:], "MST") {
+				return found(i, 3, formatOther)
+			}
+		case '0':
+			if i+1 < len(layout) && '1' <= layout[i+1] && layout[i+1] <= '6' {
+				kinds := []formatKind{formatZeroMonth, formatZeroDay, formatZeroHour12, formatZeroMinute, formatZeroSecond, formatYear}
+				return found(i, 2, kinds[layout[i+1]-'1'])
+			}
+			if strings.HasPrefix(layout[i:], "002") {
+				return found(i, 3, formatOther)
+			}
+		case '1':
+			if strings.HasPrefix(layout[i:], "15") {
+				return found(i, 2, formatHour)
+			}
+			return found(i, 1, formatNumMonth)
+		case '2':
+			if strings.HasPrefix(layout[i:], "2006") {
+				return found(i, 4, formatLongYear)
+			}
+			return found(i, 1, formatDay)
+		case '_':
+			if strings.HasPrefix(layout[i:], "_2006") {
+				return found(i+1, 4, formatLongYear)
+			}
+			if strings.HasPrefix(layout[i:], "_2") {
+				return found(i, 2, formatUnderDay)
+			}
+			if strings.HasPrefix(layout[i:], "__2") {
+				return found(i, 3, formatOther)
+			}
+		case '3':
+			return found(i, 1, formatHour12)
+		case '4':
+			return found(i, 1, formatMinute)
+		case '5':
+			return found(i, 1, formatSecond)
+		case 'P':
+			if strings.HasPrefix(layout[i:], "PM") {
+				return found(i, 2, formatPM)
+			}
+		case 'p':
+			if strings.HasPrefix(layout[i:], "pm") {
+				return found(i, 2, formatLowerPM)
+			}
+		case '-', 'Z':
+			for _, zone := range []string{"070000", "07:00:00", "0700", "07:00", "07"} {
+				if strings.HasPrefix(layout[i+1:], zone) {
+					return found(i, 1+len(zone), formatOther)
+				}
+			}
+		case '.', ',':
+			if i+1 < len(layout) && (layout[i+1] == '0' || layout[i+1] == '9') {
+				j := i + 1
+				for j < len(layout) && layout[j] == layout[i+1] {
+					j++
+				}
+				if j == len(layout) || layout[j] < '0' || layout[j] > '9' {
+					return found(i, j-i, formatOther)
+				}
+			}
+		}
+	}
+	return layout, "", formatLiteral, ""
+}
+
+// startsWithLower reports whether s starts with a lowercase ASCII letter.
+func startsWithLower(s string) bool {
+	return s != "" && 'a' <= s[0] && s[0] <= 'z'
+}
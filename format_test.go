@@ -0,0 +1,165 @@
+package zeit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatLocalized(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		locale   *Locale
+		time     time.Time
+		name     string
+		layout   string
+		expected string
+	}{
+		{English, time.Date(2024, 1, 2, 14, 30, 0, 0, berlin), "English ordinal", "January 2nd, 2006", "January 2nd, 2024"},
+		{English, time.Date(2024, 1, 15, 14, 30, 0, 0, berlin), "English th", "January 2nd, 2006", "January 15th, 2024"},
+		{English, time.Date(2024, 3, 1, 9, 5, 0, 0, berlin), "English st and AM", "Mon, Jan 2nd 3:04 PM", "Fri, Mar 1st 9:05 AM"},
+		{English, time.Date(2024, 3, 23, 21, 5, 0, 0, berlin), "English rd and pm", "2nd 3:04pm", "23rd 9:05pm"},
+		{English, time.Date(2024, 3, 11, 12, 0, 0, 0, berlin), "English teens", "2nd", "11th"},
+		{English, time.Date(2024, 3, 12, 12, 0, 0, 0, berlin), "English 12th", "2nd", "12th"},
+		{German, time.Date(2024, 3, 15, 14, 30, 0, 0, berlin), "German", "Monday, 2nd January 2006", "Freitag, 15. März 2024"},
+		{German, time.Date(2024, 3, 15, 8, 30, 0, 0, berlin), "German 12-hour clock", "3:04 PM", "8:30 vorm."},
+		{nil, time.Date(2024, 1, 22, 0, 0, 0, 0, berlin), "Nil locale", "January 2nd", "January 22nd"},
+		{English, time.Date(2024, 1, 22, 0, 0, 0, 0, berlin), "Plain layout", "2006-01-02 15:04 MST", "2024-01-22 00:00 CET"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := New(tt.time, berlin)
+			if got := z.FormatLocalized(tt.layout, tt.locale); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestFormatLocalized_Timezone(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	// 20:00 UTC on Jan 1 is the morning of Jan 2 in Tokyo
+	z := New(time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC), tokyo)
+
+	if got := z.FormatLocalized("January 2nd 3 PM", English); got != "January 2nd 5 AM" {
+		t.Errorf("Expected Tokyo local date, got %q", got)
+	}
+}
+
+func TestFormatter_MatchesFormat(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	newYork, _ := time.LoadLocation("America/New_York")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	layouts := []string{
+		time.RFC3339, time.RFC3339Nano, time.RFC1123, time.RFC1123Z, time.RFC822, time.RFC850,
+		time.ANSIC, time.UnixDate, time.Kitchen, time.Stamp, time.StampMicro, time.DateTime,
+		"2006-01-02", "02.01.2006 15:04", "1/2/06 3:04 pm", "_2 Jan 2006", "__2 002",
+		"Monday, January 2, 2006", "Mon Jan _2", "Janet Monty", "Jan.2", "_2006",
+		"15:04:05.000", "15:04:05,999999", "05.0001", "-07 -0700 -07:00 -070000 -07:00:00",
+		"Z07 Z0700 Z07:00 MST", "year 2006 day 2 of month 1", "", "no tokens here", "PM pm Pm",
+	}
+	instants := []time.Time{
+		time.Date(2024, 1, 5, 9, 4, 5, 0, time.UTC),
+		time.Date(2024, 7, 15, 23, 59, 59, 123456789, time.UTC),
+		time.Date(2024, 3, 31, 0, 30, 0, 120000000, time.UTC),
+		time.Date(2024, 11, 30, 12, 0, 0, 500, time.UTC),
+		time.Date(1999, 12, 31, 11, 0, 7, 0, time.UTC),
+	}
+
+	for _, layout := range layouts {
+		for _, loc := range []*time.Location{nil, time.UTC, berlin, newYork} {
+			f := NewFormatter(layout, loc)
+			for _, instant := range instants {
+				z := New(instant, tokyo)
+				want := z.Format(layout)
+				if loc != nil {
+					want = z.In(loc).Format(layout)
+				}
+				if got := f.Format(z); got != want {
+					t.Errorf("%q in %v: expected %q, got %q", layout, loc, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestFormatter_EveryToken(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	oddOffset := time.FixedZone("", -(3*3600 + 25*60 + 52))
+
+	tokens := []string{
+		"January", "Jan", "1", "01", "Monday", "Mon", "2", "_2", "02", "__2", "002",
+		"15", "3", "03", "4", "04", "5", "05", "2006", "_2006", "06", "PM", "pm", "MST",
+		"Z0700", "Z070000", "Z07", "Z07:00", "Z07:00:00",
+		"-0700", "-070000", "-07", "-07:00", "-07:00:00",
+		".0", ".00", ".000", ".000000", ".000000000", ".9", ".999", ".999999", ".999999999",
+		",000", ",999",
+	}
+	instants := []time.Time{
+		time.Date(2024, 1, 5, 9, 4, 5, 0, time.UTC),
+		time.Date(2024, 10, 27, 2, 30, 0, 100, berlin),
+		time.Date(1999, 12, 31, 0, 0, 0, 999999999, time.UTC),
+		time.Date(2000, 2, 29, 12, 0, 59, 0, oddOffset),
+		time.Date(5, 6, 7, 8, 9, 10, 0, time.UTC),
+		time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(-45, 3, 15, 13, 0, 0, 0, time.UTC),
+		time.Date(-1, 12, 31, 23, 59, 59, 0, time.UTC),
+		time.Date(12345, 8, 9, 21, 0, 0, 0, time.UTC),
+	}
+
+	for _, token := range tokens {
+		for _, layout := range []string{token, "<" + token + ">", token + token, token + "x"} {
+			f := NewFormatter(layout, nil)
+			for _, instant := range instants {
+				z := New(instant, instant.Location())
+				if got, want := f.Format(z), instant.Format(layout); got != want {
+					t.Errorf("%q for %v: expected %q, got %q", layout, instant, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestFormatter_AppendFormat(t *testing.T) {
+	f := NewFormatter(time.DateOnly, nil)
+	z := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), time.UTC)
+
+	buf := []byte("date=")
+	buf = f.AppendFormat(buf, z)
+	if string(buf) != "date=2024-01-15" {
+		t.Errorf("Expected date=2024-01-15, got %s", buf)
+	}
+	if got := f.AppendFormat(buf[:0], nil); len(got) != 0 {
+		t.Errorf("Expected nothing for nil Zeit, got %s", got)
+	}
+	if f.Format(nil) != "" {
+		t.Error("Expected empty string for nil Zeit")
+	}
+	if f.Layout() != time.DateOnly {
+		t.Errorf("Expected layout %s, got %s", time.DateOnly, f.Layout())
+	}
+}
+
+func BenchmarkFormatter(b *testing.B) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := New(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), berlin)
+	f := NewFormatter(time.DateTime, berlin)
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		buf = f.AppendFormat(buf[:0], z)
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := New(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), berlin)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = z.Format(time.DateTime)
+	}
+}
@@ -0,0 +1,171 @@
+package zeit
+
+import "time"
+
+// WorkWindow is a daily working window in local wall-clock time, given as offsets
+// from midnight, e.g. WorkWindow{Start: 9 * time.Hour, End: 12 * time.Hour}.
+type WorkWindow struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// BusinessHours describes working time: one or more daily windows in a timezone,
+// on the business days of a Calendar. Multiple windows model breaks, e.g.
+// 09:00-12:00 and 13:00-17:00 to pause SLAs over lunch. Windows should be sorted
+// and must not overlap. A nil Location means UTC.
+type BusinessHours struct {
+	Location *time.Location
+	Calendar *Calendar
+	Windows  []WorkWindow
+}
+
+// NewBusinessHours creates BusinessHours for the given location, calendar and windows.
+// A nil calendar means Monday to Friday without holidays.
+func NewBusinessHours(loc *time.Location, cal *Calendar, windows ...WorkWindow) *BusinessHours {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return &BusinessHours{
+		Location: loc,
+		Calendar: cal,
+		Windows:  windows,
+	}
+}
+
+// BusinessHoursBetween returns the working time within the duration according to bh.
+func (d *Duration) BusinessHoursBetween(bh *BusinessHours) time.Duration {
+	start, end := d.ordered()
+
+	var total time.Duration
+	for _, iv := range bh.workingIntervals(start, end) {
+		total += iv.end.Sub(iv.start)
+	}
+	return total
+}
+
+// FindCommonSlots returns back-to-back slots of slotLen on the day of date, in date's
+// timezone, during which every participant is within their business hours.
+// Slots start at the beginning of each shared working stretch and never cross
+// its end. The returned Periods use date's timezone.
+func FindCommonSlots(participants map[string]BusinessHours, date *Zeit, slotLen time.Duration) []*Period {
+	if len(participants) == 0 || slotLen <= 0 {
+		return nil
+	}
+
+	dayStart := startOfDay(date.Time())
+	common := []interval{{start: dayStart, end: dayStart.AddDate(0, 0, 1)}}
+	for _, bh := range participants {
+		common = intersectIntervals(common, bh.workingIntervals(common[0].start, common[len(common)-1].end))
+		if len(common) == 0 {
+			return nil
+		}
+	}
+
+	var slots []*Period
+	for _, iv := range common {
+		for s := iv.start; !s.Add(slotLen).After(iv.end); s = s.Add(slotLen) {
+			slots = append(slots, &Period{
+				StartsAt: New(s, date.location),
+				EndsAt:   New(s.Add(slotLen), date.location),
+			})
+		}
+	}
+	return slots
+}
+
+// interval is a half-open span of absolute time [start, end).
+type interval struct {
+	start time.Time
+	end   time.Time
+}
+
+// workingIntervals returns the working windows overlapping [start, end), clipped
+// to that range, minus calendar absences, in chronological order.
+func (bh *BusinessHours) workingIntervals(start, end time.Time) []interval {
+	loc := bh.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	var result []interval
+	for day := startOfDay(start.In(loc)); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if !bh.Calendar.isBusinessDay(day) {
+			continue
+		}
+
+		for _, w := range bh.Windows {
+			wStart := wallClock(day, w.Start)
+			wEnd := wallClock(day, w.End)
+			if wStart.Before(start) {
+				wStart = start
+			}
+			if wEnd.After(end) {
+				wEnd = end
+			}
+			if wStart.Before(wEnd) {
+				result = append(result, interval{start: wStart, end: wEnd})
+			}
+		}
+	}
+
+	for _, a := range bh.Calendar.allAbsences() {
+		result = subtractPeriod(result, a)
+	}
+
+	return result
+}
+
+// intersectIntervals returns the spans covered by both a and b.
+// Both inputs must be sorted and free of overlaps.
+func intersectIntervals(a, b []interval) []interval {
+	var result []interval
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		start := maxTime(a[i].start, b[j].start)
+		end := minTime(a[i].end, b[j].end)
+		if start.Before(end) {
+			result = append(result, interval{start: start, end: end})
+		}
+		if a[i].end.Before(b[j].end) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+// subtractPeriod removes the time covered by p from each interval.
+func subtractPeriod(intervals []interval, p *Period) []interval {
+	var result []interval
+	for _, iv := range intervals {
+		if iv.start.Before(p.StartsAt.instant) {
+			result = append(result, interval{start: iv.start, end: minTime(iv.end, p.StartsAt.instant)})
+		}
+		if p.EndsAt != nil && iv.end.After(p.EndsAt.instant) {
+			result = append(result, interval{start: maxTime(iv.start, p.EndsAt.instant), end: iv.end})
+		}
+	}
+	return result
+}
+
+// minTime returns the earlier of a and b.
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// maxTime returns the later of a and b.
+func maxTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// wallClock returns the instant at the given wall-clock offset from midnight of day,
+// so that 09:00 stays 09:00 local time across DST changes.
+func wallClock(day time.Time, offset time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, int(offset), day.Location())
+}
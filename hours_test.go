@@ -0,0 +1,223 @@
+package zeit
+
+import (
+	"testing"
+	"time"
+)
+
+func lunchBreakHours(loc *time.Location, cal *Calendar) *BusinessHours {
+	return NewBusinessHours(loc, cal,
+		WorkWindow{Start: 9 * time.Hour, End: 12 * time.Hour},
+		WorkWindow{Start: 13 * time.Hour, End: 17 * time.Hour},
+	)
+}
+
+func TestDuration_BusinessHoursBetween(t *testing.T) {
+	bh := lunchBreakHours(time.UTC, nil)
+
+	tests := []struct {
+		start    time.Time
+		end      time.Time
+		name     string
+		expected time.Duration
+	}{
+		{
+			name:     "Full working day",
+			start:    time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC),
+			expected: 7 * time.Hour,
+		},
+		{
+			name:     "Lunch break pauses the clock",
+			start:    time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC),
+			end:      time.Date(2024, 1, 15, 14, 0, 0, 0, time.UTC),
+			expected: 2 * time.Hour,
+		},
+		{
+			name:     "Starts during lunch",
+			start:    time.Date(2024, 1, 15, 12, 30, 0, 0, time.UTC),
+			end:      time.Date(2024, 1, 15, 13, 30, 0, 0, time.UTC),
+			expected: 30 * time.Minute,
+		},
+		{
+			name:     "Friday evening to Monday morning",
+			start:    time.Date(2024, 1, 19, 16, 0, 0, 0, time.UTC),
+			end:      time.Date(2024, 1, 22, 10, 0, 0, 0, time.UTC),
+			expected: 2 * time.Hour,
+		},
+		{
+			name:     "Weekend only",
+			start:    time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC),
+			expected: 0,
+		},
+		{
+			name:     "Reversed",
+			start:    time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			expected: 7 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := New(tt.start, time.UTC).Until(New(tt.end, time.UTC))
+			if got := d.BusinessHoursBetween(bh); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDuration_BusinessHoursBetween_Timezone(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	bh := lunchBreakHours(berlin, nil)
+
+	// 08:00-10:00 UTC on a winter Monday is 09:00-11:00 in Berlin
+	start := New(time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC), time.UTC)
+	end := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), time.UTC)
+
+	if got := start.Until(end).BusinessHoursBetween(bh); got != 2*time.Hour {
+		t.Errorf("Expected 2h, got %v", got)
+	}
+}
+
+func TestDuration_BusinessHoursBetween_Holiday(t *testing.T) {
+	cal := NewCalendar(Holiday{Name: "Christmas Day", Month: time.December, Day: 25})
+	bh := lunchBreakHours(time.UTC, cal)
+
+	// Tue Dec 24 - Thu Dec 26, 2024: Christmas contributes nothing
+	start := New(time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC), time.UTC)
+	end := New(time.Date(2024, 12, 27, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	if got := start.Until(end).BusinessHoursBetween(bh); got != 14*time.Hour {
+		t.Errorf("Expected 14h, got %v", got)
+	}
+}
+
+func TestDuration_BusinessHoursBetween_DST(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	bh := NewBusinessHours(berlin, nil, WorkWindow{Start: 9 * time.Hour, End: 17 * time.Hour})
+
+	// Windows stay at 09:00-17:00 local time on both sides of the switch
+	start := New(time.Date(2024, 3, 29, 0, 0, 0, 0, berlin), berlin)
+	end := New(time.Date(2024, 4, 2, 0, 0, 0, 0, berlin), berlin)
+
+	if got := start.Until(end).BusinessHoursBetween(bh); got != 16*time.Hour {
+		t.Errorf("Expected 16h, got %v", got)
+	}
+}
+
+func TestDuration_BusinessHoursBetween_Absences(t *testing.T) {
+	// Doctor's appointment Mon Jan 15, 2024 10:00-14:00
+	appointment := &Period{
+		StartsAt: New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), time.UTC),
+		EndsAt:   New(time.Date(2024, 1, 15, 14, 0, 0, 0, time.UTC), time.UTC),
+	}
+	bh := lunchBreakHours(time.UTC, NewCalendar().WithAbsences(appointment))
+
+	start := New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+	end := New(time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	// 09:00-10:00 and 14:00-17:00 remain
+	if got := start.Until(end).BusinessHoursBetween(bh); got != 4*time.Hour {
+		t.Errorf("Expected 4h, got %v", got)
+	}
+}
+
+func TestFindCommonSlots(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	ny, _ := time.LoadLocation("America/New_York")
+
+	participants := map[string]BusinessHours{
+		"anna": *lunchBreakHours(berlin, nil),
+		"ben":  *NewBusinessHours(ny, nil, WorkWindow{Start: 8 * time.Hour, End: 16 * time.Hour}),
+	}
+	// Tuesday Jan 16, 2024 in Berlin
+	date := New(time.Date(2024, 1, 16, 0, 0, 0, 0, berlin), berlin)
+
+	slots := FindCommonSlots(participants, date, 30*time.Minute)
+
+	// Ben starts 14:00 Berlin time; Anna works until 17:00
+	if len(slots) != 6 {
+		t.Fatalf("Expected 6 slots, got %d", len(slots))
+	}
+	first := time.Date(2024, 1, 16, 14, 0, 0, 0, berlin)
+	if !slots[0].StartsAt.Time().Equal(first) {
+		t.Errorf("First slot: expected %v, got %v", first, slots[0].StartsAt.Time())
+	}
+	last := time.Date(2024, 1, 16, 17, 0, 0, 0, berlin)
+	if !slots[5].EndsAt.Time().Equal(last) {
+		t.Errorf("Last slot end: expected %v, got %v", last, slots[5].EndsAt.Time())
+	}
+	for i, s := range slots {
+		if s.Duration() != 30*time.Minute {
+			t.Errorf("Slot %d: expected 30m, got %v", i, s.Duration())
+		}
+		if s.StartsAt.Location() != berlin {
+			t.Errorf("Slot %d should use the date's timezone", i)
+		}
+	}
+}
+
+func TestFindCommonSlots_LunchBreak(t *testing.T) {
+	participants := map[string]BusinessHours{
+		"anna": *lunchBreakHours(time.UTC, nil),
+		"ben":  *NewBusinessHours(time.UTC, nil, WorkWindow{Start: 11 * time.Hour, End: 14 * time.Hour}),
+	}
+	date := New(time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	slots := FindCommonSlots(participants, date, time.Hour)
+
+	// 11:00-12:00 and 13:00-14:00; no slot spans the break
+	if len(slots) != 2 {
+		t.Fatalf("Expected 2 slots, got %d", len(slots))
+	}
+	if slots[1].StartsAt.Time().Hour() != 13 {
+		t.Errorf("Second slot should start at 13:00, got %v", slots[1].StartsAt.Time())
+	}
+}
+
+func TestFindCommonSlots_NoOverlap(t *testing.T) {
+	participants := map[string]BusinessHours{
+		"anna": *NewBusinessHours(time.UTC, nil, WorkWindow{Start: 9 * time.Hour, End: 12 * time.Hour}),
+		"ben":  *NewBusinessHours(time.UTC, nil, WorkWindow{Start: 13 * time.Hour, End: 17 * time.Hour}),
+	}
+	date := New(time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	if slots := FindCommonSlots(participants, date, 30*time.Minute); len(slots) != 0 {
+		t.Errorf("Expected no slots, got %d", len(slots))
+	}
+	if slots := FindCommonSlots(nil, date, 30*time.Minute); len(slots) != 0 {
+		t.Errorf("Expected no slots without participants, got %d", len(slots))
+	}
+}
+
+func TestFindCommonSlots_Weekend(t *testing.T) {
+	participants := map[string]BusinessHours{
+		"anna": *lunchBreakHours(time.UTC, nil),
+	}
+	saturday := New(time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	if slots := FindCommonSlots(participants, saturday, 30*time.Minute); len(slots) != 0 {
+		t.Errorf("Expected no slots on a weekend, got %d", len(slots))
+	}
+}
+
+func TestFindCommonSlots_ZeroLocation(t *testing.T) {
+	participants := map[string]BusinessHours{
+		"anna": {Windows: []WorkWindow{{Start: 9 * time.Hour, End: 12 * time.Hour}}},
+		"ben":  *NewBusinessHours(time.UTC, nil, WorkWindow{Start: 11 * time.Hour, End: 17 * time.Hour}),
+	}
+	date := New(time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	slots := FindCommonSlots(participants, date, time.Hour)
+
+	// A literal without a Location works in UTC like NewBusinessHours
+	if len(slots) != 1 {
+		t.Fatalf("Expected 1 slot, got %d", len(slots))
+	}
+	if slots[0].StartsAt.Time().Hour() != 11 {
+		t.Errorf("Slot should start at 11:00 UTC, got %v", slots[0].StartsAt.Time())
+	}
+}
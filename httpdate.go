@@ -0,0 +1,75 @@
+package zeit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// httpDateLayout is the preferred HTTP-date format of RFC 9110, always in GMT.
+const httpDateLayout = "Mon, 02 Jan 2006 15:04:05 GMT"
+
+// httpDateLayouts are the HTTP-date formats recipients must accept: the preferred
+// IMF-fixdate and the obsolete RFC 850 and asctime forms.
+var httpDateLayouts = []string{httpDateLayout, "Monday, 02-Jan-06 15:04:05 GMT", time.ANSIC}
+
+// maxDurationSeconds is the largest whole number of seconds a time.Duration holds.
+const maxDurationSeconds = int64(1<<63-1) / int64(time.Second)
+
+// ToHTTPDate formats the Zeit as an HTTP-date for headers such as Expires,
+// Last-Modified and Retry-After: "Mon, 15 Jan 2024 10:30:00 GMT". HTTP-dates are
+// always in GMT and have no fractional seconds, which are truncated.
+func (z *Zeit) ToHTTPDate() string {
+	return z.instant.Format(httpDateLayout)
+}
+
+// ParseRetryAfter parses the value of a Retry-After header and returns the time
+// after which the request may be retried, in now's timezone. The header holds
+// either delta-seconds, counted from now, or an HTTP-date, which may lie before
+// now. Pass the time the response was received as now; a nil now uses Now in UTC.
+// Returns ErrInvalidFormat for values in neither form.
+func ParseRetryAfter(headerValue string, now *Zeit) (*Zeit, error) {
+	if now == nil {
+		now = Now(nil)
+	}
+	value := strings.TrimSpace(headerValue)
+
+	if value != "" && strings.Trim(value, "0123456789") == "" {
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || seconds > maxDurationSeconds {
+			return nil, fmt.Errorf("%w: retry-after %q", ErrInvalidFormat, headerValue)
+		}
+		return now.Add(time.Duration(seconds) * time.Second), nil
+	}
+
+	for _, layout := range httpDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return New(t, now.location), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: retry-after %q", ErrInvalidFormat, headerValue)
+}
+
+// MaxAgeUntil returns the whole seconds from z until expiry, for the max-age
+// directive of a Cache-Control header. Partial seconds are dropped so caches never
+// keep a response past expiry. Returns 0 if expiry is nil or not after z.
+//
+//	w.Header().Set("Cache-Control", "max-age="+strconv.Itoa(now.MaxAgeUntil(expiry)))
+//	w.Header().Set("Expires", expiry.ExpiresHeader())
+func (z *Zeit) MaxAgeUntil(expiry *Zeit) int {
+	if expiry == nil || !z.Before(expiry) {
+		return 0
+	}
+	return int(expiry.instant.Sub(z.instant) / time.Second)
+}
+
+// ExpiresHeader returns the value of an Expires header for a response that
+// expires at the Zeit, an HTTP-date like ToHTTPDate. A nil Zeit returns "0",
+// which caches treat as already expired.
+func (z *Zeit) ExpiresHeader() string {
+	if z == nil {
+		return "0"
+	}
+	return z.ToHTTPDate()
+}
@@ -0,0 +1,120 @@
+package zeit
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestToHTTPDate(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := New(time.Date(2024, 1, 15, 10, 30, 0, 999000000, time.UTC), berlin)
+
+	if got := z.ToHTTPDate(); got != "Mon, 15 Jan 2024 10:30:00 GMT" {
+		t.Errorf("Expected Mon, 15 Jan 2024 10:30:00 GMT, got %s", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	now := New(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), berlin)
+
+	tests := []struct {
+		expected time.Time
+		name     string
+		value    string
+	}{
+		{name: "Delta seconds", value: "120", expected: time.Date(2024, 1, 15, 10, 32, 0, 0, time.UTC)},
+		{name: "Zero", value: "0", expected: now.instant},
+		{name: "Surrounding spaces", value: " 3600 ", expected: time.Date(2024, 1, 15, 11, 30, 0, 0, time.UTC)},
+		{name: "IMF-fixdate", value: "Mon, 15 Jan 2024 11:00:00 GMT", expected: time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{name: "RFC 850", value: "Monday, 15-Jan-24 11:00:00 GMT", expected: time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{name: "asctime", value: "Mon Jan 15 11:00:00 2024", expected: time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{name: "Date in the past", value: "Mon, 15 Jan 2024 09:00:00 GMT", expected: time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z, err := ParseRetryAfter(tt.value, now)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !z.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, z.instant)
+			}
+			if z.Location() != berlin {
+				t.Error("Result should be in now's location")
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter_NilNow(t *testing.T) {
+	before := time.Now()
+	z, err := ParseRetryAfter("60", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if z.instant.Before(before.Add(time.Minute)) || z.Location() != time.UTC {
+		t.Errorf("Expected a minute from now in UTC, got %v", z.ToUser())
+	}
+}
+
+func TestParseRetryAfter_Invalid(t *testing.T) {
+	now := New(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), time.UTC)
+
+	for _, value := range []string{"", "-5", "1.5", "soon", "2024-01-15T11:00:00Z", "Mon, 15 Jan 2024 11:00:00 CET", "99999999999999999999"} {
+		if _, err := ParseRetryAfter(value, now); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Expected %v for %q, got %v", ErrInvalidFormat, value, err)
+		}
+	}
+}
+
+func TestHTTPDate_RoundTrip(t *testing.T) {
+	now := New(time.Date(2024, 7, 3, 9, 41, 27, 0, time.UTC), time.UTC)
+
+	z, err := ParseRetryAfter(now.ToHTTPDate(), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !z.Equal(now) {
+		t.Errorf("Expected %v, got %v", now.ToUser(), z.ToUser())
+	}
+}
+
+func TestMaxAgeUntil(t *testing.T) {
+	now := New(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		expiry   *Zeit
+		name     string
+		expected int
+	}{
+		{name: "One hour", expiry: now.Add(time.Hour), expected: 3600},
+		{name: "Partial second dropped", expiry: now.Add(90*time.Second + 999*time.Millisecond), expected: 90},
+		{name: "Less than a second", expiry: now.Add(500 * time.Millisecond), expected: 0},
+		{name: "At now", expiry: now, expected: 0},
+		{name: "In the past", expiry: now.Add(-time.Hour), expected: 0},
+		{name: "Nil", expiry: nil, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := now.MaxAgeUntil(tt.expiry); got != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpiresHeader(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	expiry := New(time.Date(2024, 1, 15, 11, 30, 0, 0, time.UTC), berlin)
+
+	if got := expiry.ExpiresHeader(); got != "Mon, 15 Jan 2024 11:30:00 GMT" {
+		t.Errorf("Expected Mon, 15 Jan 2024 11:30:00 GMT, got %s", got)
+	}
+	if got := (*Zeit)(nil).ExpiresHeader(); got != "0" {
+		t.Errorf("Expected 0 for nil Zeit, got %s", got)
+	}
+}
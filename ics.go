@@ -0,0 +1,529 @@
+package zeit
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence is an event that repeats at a BillingInterval, such as a billing
+// schedule or a maintenance window. First is the first occurrence, and every
+// occurrence lasts as long as it. The series ends after Count occurrences or at
+// Until; set at most one of them, or neither for a series that repeats forever.
+type Recurrence struct {
+	First    *Period
+	Until    *Zeit
+	Interval BillingInterval
+	Count    int
+}
+
+// icsLocalLayout and icsUTCLayout are the iCalendar DATE-TIME forms with a TZID
+// and in UTC.
+const (
+	icsLocalLayout = "20060102T150405"
+	icsUTCLayout   = "20060102T150405Z"
+)
+
+// ToICS formats the period as an iCalendar VEVENT block with CRLF line endings,
+// for calendar subscriptions. Endpoints in a named IANA zone carry it as TZID so
+// calendar apps show local times; other zones are written in UTC. Open-ended
+// periods have no DTEND. The UID is derived from the endpoints, so re-exporting a
+// period updates the same event. Returns an empty string for a nil Period.
+// Wrap events in ICSCalendar for a complete .ics file with the VTIMEZONE
+// definitions the TZIDs refer to.
+func (p *Period) ToICS() string {
+	if p == nil || p.StartsAt == nil {
+		return ""
+	}
+	return icsEvent(p, "")
+}
+
+// ToICS formats the recurrence as an iCalendar VEVENT block with an RRULE, e.g.
+// FREQ=MONTHLY;INTERVAL=3 for Quarterly. Calendar apps expand the rule per
+// RFC 5545, which skips months without the start day: a series starting on the
+// 31st has no occurrence in April. Export the periods from Cycles one by one to
+// keep zeit's month-end handling. Returns an empty string if r or r.First is nil.
+func (r *Recurrence) ToICS() string {
+	if r == nil || r.First == nil || r.First.StartsAt == nil {
+		return ""
+	}
+
+	rule := "FREQ=" + r.Interval.icsFreq()
+	switch {
+	case r.Count > 0:
+		rule += ";COUNT=" + strconv.Itoa(r.Count)
+	case r.Until != nil:
+		rule += ";UNTIL=" + r.Until.instant.Format(icsUTCLayout)
+	}
+	return icsEvent(r.First, rule)
+}
+
+// icsZoneHorizon is the last year VTIMEZONE definitions cover for series that
+// repeat forever, the horizon up to which zic writes explicit transitions.
+const icsZoneHorizon = 2037
+
+// ICSCalendar wraps VEVENT blocks from ToICS in a VCALENDAR, producing a complete
+// iCalendar file that calendar apps can subscribe to:
+//
+//	zeit.ICSCalendar(trial.ToICS(), schedule.ToICS())
+//
+// Each TZID the events use gets the VTIMEZONE that RFC 5545 requires, listing the
+// zone's UTC offset changes from the first event through the last occurrence.
+// Series that repeat forever are covered through 2037.
+func ICSCalendar(events ...string) string {
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//dnl-fm//zeit-go//EN\r\n")
+	for _, tz := range icsZones(events) {
+		b.WriteString(tz.vtimezone())
+	}
+	for _, e := range events {
+		b.WriteString(e)
+	}
+	b.WriteString("END:VCALENDAR\r\n")
+	return b.String()
+}
+
+// icsZone is a timezone used by the events of a calendar and the span of time
+// its VTIMEZONE has to cover.
+type icsZone struct {
+	loc   *time.Location
+	first time.Time
+	last  time.Time
+}
+
+// icsZones returns the zones the events refer to with a TZID, sorted by name.
+func icsZones(events []string) []*icsZone {
+	zones := map[string]*icsZone{}
+	use := func(z *Zeit, last time.Time) {
+		if z == nil || z.location == time.UTC {
+			return
+		}
+		name := z.location.String()
+		if tz, ok := zones[name]; ok {
+			tz.first = minTime(tz.first, z.instant)
+			tz.last = maxTime(tz.last, last)
+			return
+		}
+		zones[name] = &icsZone{loc: z.location, first: z.instant, last: last}
+	}
+
+	for _, e := range events {
+		parsed, err := ParseICS(e, nil)
+		if err != nil {
+			continue
+		}
+		for _, event := range parsed {
+			last := event.Period.StartsAt.instant
+			if event.Recurrence != nil {
+				last = event.Recurrence.lastStart()
+			}
+			use(event.Period.StartsAt, last)
+			use(event.Period.EndsAt, last.Add(event.Period.Duration()))
+		}
+	}
+
+	names := make([]string, 0, len(zones))
+	for name := range zones {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	result := make([]*icsZone, len(names))
+	for i, name := range names {
+		result[i] = zones[name]
+	}
+	return result
+}
+
+// lastStart returns the start of the last occurrence of a series read by ParseICS,
+// capped at the end of icsZoneHorizon, which series that repeat forever reach.
+func (r *Recurrence) lastStart() time.Time {
+	first := r.First.StartsAt
+	horizon := maxTime(first.instant, time.Date(icsZoneHorizon+1, time.January, 1, 0, 0, 0, 0, time.UTC))
+	switch {
+	case r.Count > 0:
+		return minTime(r.Interval.advanceBy(first.instant, r.Count-1), horizon)
+	case r.Until != nil:
+		return minTime(r.Until.instant, horizon)
+	default:
+		return horizon
+	}
+}
+
+// vtimezone formats the zone as a VTIMEZONE block with one STANDARD or DAYLIGHT
+// observance per offset change, starting with the observance in effect at first.
+func (tz *icsZone) vtimezone() string {
+	var b strings.Builder
+	b.WriteString("BEGIN:VTIMEZONE\r\nTZID:" + tz.loc.String() + "\r\n")
+
+	t := tz.first.In(tz.loc)
+	if start, _ := t.ZoneBounds(); !start.IsZero() {
+		t = start
+	}
+	writeICSObservance(&b, t)
+
+	for {
+		_, end := t.ZoneBounds()
+		if end.IsZero() || end.After(tz.last) {
+			break
+		}
+		t = end
+		writeICSObservance(&b, t)
+	}
+
+	b.WriteString("END:VTIMEZONE\r\n")
+	return b.String()
+}
+
+// writeICSObservance writes the observance of the zone that takes effect at t.
+// Its DTSTART is the local time just before the change, as RFC 5545 requires.
+func writeICSObservance(b *strings.Builder, t time.Time) {
+	_, from := t.Add(-time.Nanosecond).Zone()
+	name, to := t.Zone()
+
+	kind := "STANDARD"
+	if t.IsDST() {
+		kind = "DAYLIGHT"
+	}
+
+	b.WriteString("BEGIN:" + kind + "\r\n")
+	b.WriteString("DTSTART:" + t.In(time.FixedZone("", from)).Format(icsLocalLayout) + "\r\n")
+	b.WriteString("TZOFFSETFROM:" + icsOffset(from) + "\r\n")
+	b.WriteString("TZOFFSETTO:" + icsOffset(to) + "\r\n")
+	b.WriteString("TZNAME:" + name + "\r\n")
+	b.WriteString("END:" + kind + "\r\n")
+}
+
+// icsOffset formats a UTC offset in seconds as an iCalendar UTC-OFFSET, "+0100",
+// with seconds only where the offset has them.
+func icsOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign, seconds = '-', -seconds
+	}
+	offset := fmt.Sprintf("%c%02d%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		offset += fmt.Sprintf("%02d", seconds%60)
+	}
+	return offset
+}
+
+// icsEvent formats p as a VEVENT with an optional RRULE.
+func icsEvent(p *Period, rule string) string {
+	end := ""
+	if p.EndsAt != nil {
+		end = p.EndsAt.instant.Format(icsUTCLayout)
+	}
+	h := fnv.New64a()
+	h.Write([]byte(p.StartsAt.instant.Format(icsUTCLayout) + "/" + end + "/" + rule))
+
+	var b strings.Builder
+	b.WriteString("BEGIN:VEVENT\r\n")
+	fmt.Fprintf(&b, "UID:%016x@zeit-go\r\n", h.Sum64())
+	b.WriteString("DTSTAMP:" + now().Format(icsUTCLayout) + "\r\n")
+	b.WriteString(icsProperty("DTSTART", p.StartsAt))
+	if p.EndsAt != nil {
+		b.WriteString(icsProperty("DTEND", p.EndsAt))
+	}
+	if rule != "" {
+		b.WriteString("RRULE:" + rule + "\r\n")
+	}
+	b.WriteString("SUMMARY:" + strings.ToUpper(p.Kind.String()[:1]) + p.Kind.String()[1:] + " period\r\n")
+	b.WriteString("END:VEVENT\r\n")
+	return b.String()
+}
+
+// icsProperty formats a DATE-TIME property line for z, with a TZID parameter if
+// z's timezone is a named IANA zone and in UTC otherwise.
+func icsProperty(name string, z *Zeit) string {
+	zone := z.Location().String()
+	if zone != "UTC" && zone != "Local" {
+		if _, err := time.LoadLocation(zone); err == nil {
+			return name + ";TZID=" + zone + ":" + z.Time().Format(icsLocalLayout) + "\r\n"
+		}
+	}
+	return name + ":" + z.instant.Format(icsUTCLayout) + "\r\n"
+}
+
+// icsFreq returns the RRULE frequency of the interval, with an INTERVAL part
+// for multi-month intervals.
+func (i BillingInterval) icsFreq() string {
+	switch i {
+	case Weekly:
+		return "WEEKLY"
+	case Monthly:
+		return "MONTHLY"
+	case Quarterly:
+		return "MONTHLY;INTERVAL=3"
+	case SemiAnnually:
+		return "MONTHLY;INTERVAL=6"
+	case Yearly:
+		return "YEARLY"
+	default:
+		return "DAILY"
+	}
+}
+
+// ICSEvent is a VEVENT read by ParseICS. Period spans the first occurrence, and
+// Recurrence is nil unless the event has an RRULE.
+type ICSEvent struct {
+	Period     *Period
+	Recurrence *Recurrence
+	UID        string
+	Summary    string
+}
+
+// ParseICS reads the VEVENTs of iCalendar data, such as a customer's maintenance
+// window feed, in file order. Lines may end in CRLF or LF and may be folded.
+//
+// DTSTART and DTEND with a TZID keep that zone, which may be an IANA name or a
+// Windows ID as sent by Outlook; UTC times and floating times without a TZID are
+// placed in loc. All-day dates are local midnights in the event's zone. Without
+// DTEND, the end follows from DURATION, is one day after an all-day start, or
+// equals the start. A nil loc defaults to UTC.
+//
+// RRULEs are supported as far as a BillingInterval expresses them: FREQ with an
+// INTERVAL of 1, or 3 and 6 for MONTHLY, plus COUNT or UNTIL. BYDAY, BYMONTHDAY
+// and BYMONTH are accepted only when they repeat DTSTART. Other rule parts fail
+// with ErrInvalidFormat. EXDATE, RDATE, nested components such as VALARM and all
+// other properties are ignored. Unknown TZIDs return ErrUnknownTimezone.
+func ParseICS(data string, loc *time.Location) ([]*ICSEvent, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	data = strings.ReplaceAll(data, "\n ", "")
+	data = strings.ReplaceAll(data, "\n\t", "")
+
+	events := []*ICSEvent{}
+	var props map[string]icsProp
+	depth := 0
+
+	for line := range strings.SplitSeq(data, "\n") {
+		if line == "" {
+			continue
+		}
+		name, params, value, err := splitICSLine(line)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case name == "BEGIN" && value == "VEVENT" && props == nil:
+			props = map[string]icsProp{}
+		case name == "BEGIN" && props != nil:
+			depth++
+		case name == "END" && props != nil && depth > 0:
+			depth--
+		case name == "END" && value == "VEVENT" && props != nil:
+			event, err := icsEventFrom(props, loc)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+			props = nil
+		case props != nil && depth == 0:
+			if _, seen := props[name]; !seen {
+				props[name] = icsProp{params: params, value: value}
+			}
+		}
+	}
+
+	if props != nil {
+		return nil, fmt.Errorf("%w: unterminated VEVENT", ErrInvalidFormat)
+	}
+	return events, nil
+}
+
+// icsProp is a property value with its parameters, e.g. TZID.
+type icsProp struct {
+	params map[string]string
+	value  string
+}
+
+// splitICSLine splits an unfolded content line into its name, parameters and value.
+// Names and parameter names are upper-cased; quoted parameter values are unquoted.
+func splitICSLine(line string) (string, map[string]string, string, error) {
+	head, value, ok := cutUnquoted(line, ':')
+	if !ok {
+		return "", nil, "", fmt.Errorf("%w: iCalendar line %q", ErrInvalidFormat, line)
+	}
+
+	parts := strings.Split(head, ";")
+	params := map[string]string{}
+	for _, part := range parts[1:] {
+		key, val, _ := strings.Cut(part, "=")
+		params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+	}
+	return strings.ToUpper(parts[0]), params, strings.TrimSpace(value), nil
+}
+
+// cutUnquoted is like strings.Cut on sep, ignoring occurrences inside double quotes.
+func cutUnquoted(s string, sep byte) (string, string, bool) {
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '"':
+			quoted = !quoted
+		case s[i] == sep && !quoted:
+			return s[:i], s[i+1:], true
+		}
+	}
+	return s, "", false
+}
+
+// icsEventFrom builds an event from the first occurrence of each VEVENT property.
+func icsEventFrom(props map[string]icsProp, loc *time.Location) (*ICSEvent, error) {
+	dtstart, ok := props["DTSTART"]
+	if !ok {
+		return nil, fmt.Errorf("%w: VEVENT without DTSTART", ErrInvalidFormat)
+	}
+	start, allDay, err := parseICSTime(dtstart, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var end *Zeit
+	if dtend, ok := props["DTEND"]; ok {
+		if end, _, err = parseICSTime(dtend, loc); err != nil {
+			return nil, err
+		}
+	} else if duration, ok := props["DURATION"]; ok {
+		d, err := parseISODuration(duration.value)
+		if err != nil {
+			return nil, err
+		}
+		end = New(d.addTo(start.Time()), start.location)
+	} else if allDay {
+		end = start.addLocalDays(1)
+	} else {
+		end = start
+	}
+
+	period := &Period{StartsAt: start, EndsAt: end}
+	if !period.IsValid() {
+		return nil, fmt.Errorf("%w: VEVENT ends before it starts", ErrInvalidFormat)
+	}
+
+	event := &ICSEvent{Period: period, UID: props["UID"].value, Summary: unescapeICS(props["SUMMARY"].value)}
+	if rrule, ok := props["RRULE"]; ok {
+		if event.Recurrence, err = parseRRULE(rrule.value, period); err != nil {
+			return nil, err
+		}
+	}
+	return event, nil
+}
+
+// parseICSTime parses a DATE or DATE-TIME property value and reports whether it
+// is a DATE. Values with a TZID are placed in that zone, others in loc.
+func parseICSTime(p icsProp, loc *time.Location) (*Zeit, bool, error) {
+	zone := loc
+	if tzid := p.params["TZID"]; tzid != "" {
+		var err error
+		if zone, err = LoadLocation(tzid); err != nil {
+			if zone, err = FromWindowsZone(tzid); err != nil {
+				return nil, false, fmt.Errorf("%w: TZID %q", ErrUnknownTimezone, tzid)
+			}
+		}
+	}
+
+	var t time.Time
+	var err error
+	allDay := p.params["VALUE"] == "DATE" || len(p.value) == len("20060102")
+	switch {
+	case allDay:
+		t, err = time.ParseInLocation("20060102", p.value, zone)
+	case strings.HasSuffix(p.value, "Z"):
+		t, err = time.Parse(icsUTCLayout, p.value)
+	default:
+		t, err = time.ParseInLocation(icsLocalLayout, p.value, zone)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: iCalendar time %q", ErrInvalidFormat, p.value)
+	}
+
+	z := New(t, zone)
+	if err := z.Validate(); err != nil {
+		return nil, false, err
+	}
+	return z, allDay, nil
+}
+
+// parseRRULE maps an RRULE to a Recurrence of first. UNTIL values without a
+// zone are read in the zone of first's start.
+func parseRRULE(rule string, first *Period) (*Recurrence, error) {
+	invalid := func(reason string) error {
+		return fmt.Errorf("%w: RRULE %q: %s", ErrInvalidFormat, rule, reason)
+	}
+
+	r := &Recurrence{First: first}
+	start := first.StartsAt.Time()
+	freq, interval := "", 1
+
+	for part := range strings.SplitSeq(rule, ";") {
+		key, value, _ := strings.Cut(part, "=")
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			freq = strings.ToUpper(value)
+		case "INTERVAL":
+			interval, err = strconv.Atoi(value)
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+			if r.Count <= 0 {
+				err = errors.New("count must be positive")
+			}
+		case "UNTIL":
+			r.Until, _, err = parseICSTime(icsProp{value: value}, first.StartsAt.location)
+		case "BYDAY":
+			if !strings.EqualFold(value, icsWeekdays[start.Weekday()]) {
+				err = errors.New("BYDAY must repeat DTSTART")
+			}
+		case "BYMONTHDAY":
+			if value != strconv.Itoa(start.Day()) {
+				err = errors.New("BYMONTHDAY must repeat DTSTART")
+			}
+		case "BYMONTH":
+			if value != strconv.Itoa(int(start.Month())) {
+				err = errors.New("BYMONTH must repeat DTSTART")
+			}
+		case "WKST":
+		default:
+			err = errors.New("unsupported part " + key)
+		}
+		if err != nil {
+			return nil, invalid(err.Error())
+		}
+	}
+
+	switch {
+	case freq == "DAILY" && interval == 1:
+		r.Interval = Daily
+	case freq == "WEEKLY" && interval == 1:
+		r.Interval = Weekly
+	case freq == "MONTHLY" && interval == 1:
+		r.Interval = Monthly
+	case freq == "MONTHLY" && interval == 3:
+		r.Interval = Quarterly
+	case freq == "MONTHLY" && interval == 6:
+		r.Interval = SemiAnnually
+	case freq == "YEARLY" && interval == 1:
+		r.Interval = Yearly
+	default:
+		return nil, invalid("no matching billing interval")
+	}
+	return r, nil
+}
+
+// icsWeekdays holds the iCalendar weekday codes, indexed by time.Weekday.
+var icsWeekdays = [7]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// unescapeICS resolves the backslash escapes of an iCalendar TEXT value.
+func unescapeICS(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(s)
+}
@@ -0,0 +1,362 @@
+package zeit
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// icsLines splits an iCalendar block into lines, dropping the UID and DTSTAMP
+// lines whose values are not fixed.
+func icsLines(s string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSuffix(s, "\r\n"), "\r\n") {
+		if !strings.HasPrefix(line, "UID:") && !strings.HasPrefix(line, "DTSTAMP:") {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func TestPeriod_ToICS(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, berlin), berlin)
+	end := New(time.Date(2024, 2, 1, 0, 0, 0, 0, berlin), berlin)
+
+	tests := []struct {
+		period   *Period
+		name     string
+		expected string
+	}{
+		{
+			name:     "Named zone",
+			period:   &Period{StartsAt: start, EndsAt: end},
+			expected: "BEGIN:VEVENT|DTSTART;TZID=Europe/Berlin:20240101T000000|DTEND;TZID=Europe/Berlin:20240201T000000|SUMMARY:Regular period|END:VEVENT",
+		},
+		{
+			name:     "UTC",
+			period:   &Period{StartsAt: start.In(time.UTC), EndsAt: end.In(time.UTC), Kind: PeriodTrial},
+			expected: "BEGIN:VEVENT|DTSTART:20231231T230000Z|DTEND:20240131T230000Z|SUMMARY:Trial period|END:VEVENT",
+		},
+		{
+			name:     "Fixed zone",
+			period:   &Period{StartsAt: start.In(time.FixedZone("X", 3600)), EndsAt: end},
+			expected: "BEGIN:VEVENT|DTSTART:20231231T230000Z|DTEND;TZID=Europe/Berlin:20240201T000000|SUMMARY:Regular period|END:VEVENT",
+		},
+		{
+			name:     "Open-ended",
+			period:   &Period{StartsAt: start},
+			expected: "BEGIN:VEVENT|DTSTART;TZID=Europe/Berlin:20240101T000000|SUMMARY:Regular period|END:VEVENT",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := strings.Join(icsLines(tt.period.ToICS()), "|")
+			if got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestPeriod_ToICS_Stamp(t *testing.T) {
+	p := &Period{StartsAt: FromDatabase(1704067200, time.UTC), EndsAt: FromDatabase(1706745600, time.UTC)}
+	first, second := p.ToICS(), p.Clone().ToICS()
+
+	uid := strings.Split(first, "\r\n")[1]
+	if !strings.HasPrefix(uid, "UID:") || !strings.Contains(second, uid+"\r\n") {
+		t.Errorf("Expected a stable UID, got %q and %q", first, second)
+	}
+	if other := (&Period{StartsAt: p.StartsAt, EndsAt: p.StartsAt}).ToICS(); strings.Contains(other, uid+"\r\n") {
+		t.Errorf("Expected a different UID for a different period, got %q", other)
+	}
+	if !strings.Contains(first, "\r\nDTSTAMP:") || !strings.HasSuffix(first, "END:VEVENT\r\n") {
+		t.Errorf("Expected DTSTAMP and CRLF line endings, got %q", first)
+	}
+	if (*Period)(nil).ToICS() != "" {
+		t.Error("Expected empty string for nil Period")
+	}
+}
+
+func TestRecurrence_ToICS(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := New(time.Date(2024, 1, 6, 22, 0, 0, 0, berlin), berlin)
+	first := &Period{StartsAt: start, EndsAt: start.Add(4 * time.Hour)}
+	until := New(time.Date(2024, 12, 31, 0, 0, 0, 0, berlin), berlin)
+
+	tests := []struct {
+		recurrence *Recurrence
+		name       string
+		expected   string
+	}{
+		{name: "Weekly forever", recurrence: &Recurrence{First: first, Interval: Weekly}, expected: "RRULE:FREQ=WEEKLY"},
+		{name: "Monthly count", recurrence: &Recurrence{First: first, Interval: Monthly, Count: 12}, expected: "RRULE:FREQ=MONTHLY;COUNT=12"},
+		{name: "Quarterly until", recurrence: &Recurrence{First: first, Interval: Quarterly, Until: until}, expected: "RRULE:FREQ=MONTHLY;INTERVAL=3;UNTIL=20241230T230000Z"},
+		{name: "Count wins over until", recurrence: &Recurrence{First: first, Interval: SemiAnnually, Count: 2, Until: until}, expected: "RRULE:FREQ=MONTHLY;INTERVAL=6;COUNT=2"},
+		{name: "Yearly", recurrence: &Recurrence{First: first, Interval: Yearly}, expected: "RRULE:FREQ=YEARLY"},
+		{name: "Daily", recurrence: &Recurrence{First: first, Interval: Daily}, expected: "RRULE:FREQ=DAILY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := icsLines(tt.recurrence.ToICS())
+			expected := []string{
+				"BEGIN:VEVENT",
+				"DTSTART;TZID=Europe/Berlin:20240106T220000",
+				"DTEND;TZID=Europe/Berlin:20240107T020000",
+				tt.expected,
+				"SUMMARY:Regular period",
+				"END:VEVENT",
+			}
+			if strings.Join(lines, "|") != strings.Join(expected, "|") {
+				t.Errorf("Expected %v, got %v", expected, lines)
+			}
+		})
+	}
+
+	if (&Recurrence{}).ToICS() != "" || (*Recurrence)(nil).ToICS() != "" {
+		t.Error("Expected empty string without a first occurrence")
+	}
+}
+
+func TestICSCalendar(t *testing.T) {
+	p := &Period{StartsAt: FromDatabase(1704067200, time.UTC), EndsAt: FromDatabase(1706745600, time.UTC)}
+
+	got := ICSCalendar(p.ToICS(), "", (&Recurrence{First: p, Interval: Monthly}).ToICS())
+	if !strings.HasPrefix(got, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:") || !strings.HasSuffix(got, "END:VEVENT\r\nEND:VCALENDAR\r\n") {
+		t.Errorf("Expected a VCALENDAR wrapper, got %q", got)
+	}
+	if n := strings.Count(got, "BEGIN:VEVENT"); n != 2 {
+		t.Errorf("Expected 2 events, got %d", n)
+	}
+}
+
+func TestICSCalendar_VTimezone(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := New(time.Date(2024, 1, 6, 22, 0, 0, 0, berlin), berlin)
+	p := &Period{StartsAt: start, EndsAt: start.Add(4 * time.Hour)}
+
+	tests := []struct {
+		name     string
+		events   []string
+		contains []string
+		absent   []string
+	}{
+		{
+			name:   "Single period",
+			events: []string{p.ToICS()},
+			contains: []string{
+				"BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//dnl-fm//zeit-go//EN\r\nBEGIN:VTIMEZONE\r\nTZID:Europe/Berlin\r\n",
+				"BEGIN:STANDARD\r\nDTSTART:20231029T030000\r\nTZOFFSETFROM:+0200\r\nTZOFFSETTO:+0100\r\nTZNAME:CET\r\nEND:STANDARD\r\nEND:VTIMEZONE\r\n",
+			},
+			absent: []string{"BEGIN:DAYLIGHT"},
+		},
+		{
+			name:   "Series until summer",
+			events: []string{p.ToICS(), (&Recurrence{First: p, Interval: Weekly, Count: 30}).ToICS()},
+			contains: []string{
+				"BEGIN:DAYLIGHT\r\nDTSTART:20240331T020000\r\nTZOFFSETFROM:+0100\r\nTZOFFSETTO:+0200\r\nTZNAME:CEST\r\nEND:DAYLIGHT\r\n",
+			},
+			absent: []string{"DTSTART:20241027T030000"},
+		},
+		{
+			name:     "Series forever",
+			events:   []string{(&Recurrence{First: p, Interval: Monthly}).ToICS()},
+			contains: []string{"DTSTART:20371025T030000"},
+			absent:   []string{"DTSTART:20380328T020000"},
+		},
+		{
+			name:     "Series with a huge count",
+			events:   []string{(&Recurrence{First: p, Interval: Monthly, Count: 1_000_000_000}).ToICS()},
+			contains: []string{"DTSTART:20371025T030000"},
+			absent:   []string{"DTSTART:20380328T020000"},
+		},
+		{
+			name:   "UTC only",
+			events: []string{(&Period{StartsAt: start.In(time.UTC)}).ToICS()},
+			absent: []string{"VTIMEZONE"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ICSCalendar(tt.events...)
+			if n := strings.Count(got, "BEGIN:VTIMEZONE"); n > 1 {
+				t.Errorf("Expected one VTIMEZONE per zone, got %d", n)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("Expected %q in %q", want, got)
+				}
+			}
+			for _, unwanted := range tt.absent {
+				if strings.Contains(got, unwanted) {
+					t.Errorf("Unexpected %q in %q", unwanted, got)
+				}
+			}
+		})
+	}
+}
+
+func TestParseICS(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	data := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VTIMEZONE",
+		"TZID:W. Europe Standard Time",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"UID:window-1@example.com",
+		"SUMMARY:Database maintenance\\, region eu-1",
+		`DTSTART;TZID="W. Europe Standard Time":20240106T220000`,
+		"DTEND;TZID=W. Europe Standard Time:20240107T020000",
+		"RRULE:FREQ=WEEKLY;BYDAY=SA;COUNT=",
+		" 10",
+		"BEGIN:VALARM",
+		"DTSTART:20240101T000000Z",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART:20240301T120000Z",
+		"DURATION:PT90M",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20240501",
+		"RRULE:FREQ=YEARLY;UNTIL=20300501",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART:20240601T080000",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	events, err := ParseICS(data, berlin)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(events) != 4 {
+		t.Fatalf("Expected 4 events, got %d", len(events))
+	}
+
+	tests := []struct {
+		start      time.Time
+		end        time.Time
+		recurrence *Recurrence
+		name       string
+		zone       string
+	}{
+		{
+			name:       "Windows TZID with weekly rule",
+			start:      time.Date(2024, 1, 6, 22, 0, 0, 0, berlin),
+			end:        time.Date(2024, 1, 7, 2, 0, 0, 0, berlin),
+			zone:       "Europe/Berlin",
+			recurrence: &Recurrence{Interval: Weekly, Count: 10},
+		},
+		{
+			name:  "UTC with duration",
+			start: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 3, 1, 13, 30, 0, 0, time.UTC),
+			zone:  "Europe/Berlin",
+		},
+		{
+			name:       "All-day yearly",
+			start:      time.Date(2024, 5, 1, 0, 0, 0, 0, berlin),
+			end:        time.Date(2024, 5, 2, 0, 0, 0, 0, berlin),
+			zone:       "Europe/Berlin",
+			recurrence: &Recurrence{Interval: Yearly, Until: New(time.Date(2030, 5, 1, 0, 0, 0, 0, berlin), berlin)},
+		},
+		{
+			name:  "Floating without end",
+			start: time.Date(2024, 6, 1, 8, 0, 0, 0, berlin),
+			end:   time.Date(2024, 6, 1, 8, 0, 0, 0, berlin),
+			zone:  "Europe/Berlin",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := events[i]
+			if !e.Period.StartsAt.instant.Equal(tt.start) || !e.Period.EndsAt.instant.Equal(tt.end) {
+				t.Errorf("Expected %v to %v, got %v", tt.start, tt.end, e.Period.ISO8601())
+			}
+			if got := e.Period.StartsAt.Location().String(); got != tt.zone {
+				t.Errorf("Expected zone %v, got %v", tt.zone, got)
+			}
+			if (e.Recurrence == nil) != (tt.recurrence == nil) {
+				t.Fatalf("Expected recurrence %v, got %v", tt.recurrence, e.Recurrence)
+			}
+			if e.Recurrence == nil {
+				return
+			}
+			if e.Recurrence.First != e.Period || e.Recurrence.Interval != tt.recurrence.Interval || e.Recurrence.Count != tt.recurrence.Count {
+				t.Errorf("Expected %+v, got %+v", tt.recurrence, e.Recurrence)
+			}
+			if (e.Recurrence.Until == nil) != (tt.recurrence.Until == nil) || (e.Recurrence.Until != nil && !e.Recurrence.Until.Equal(tt.recurrence.Until)) {
+				t.Errorf("Expected until %v, got %v", tt.recurrence.Until, e.Recurrence.Until)
+			}
+		})
+	}
+
+	if events[0].UID != "window-1@example.com" || events[0].Summary != "Database maintenance, region eu-1" {
+		t.Errorf("Expected UID and unescaped summary, got %q and %q", events[0].UID, events[0].Summary)
+	}
+}
+
+func TestParseICS_RoundTrip(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := New(time.Date(2024, 1, 31, 9, 0, 0, 0, berlin), berlin)
+	p := &Period{StartsAt: start, EndsAt: start.Add(time.Hour)}
+	r := &Recurrence{First: p, Interval: Quarterly, Count: 4}
+
+	events, err := ParseICS(ICSCalendar(p.ToICS(), r.ToICS()), time.UTC)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(events))
+	}
+	for _, e := range events {
+		if !e.Period.Equal(p) || e.Period.StartsAt.Location().String() != "Europe/Berlin" {
+			t.Errorf("Expected %v, got %v", p.ISO8601(), e.Period.ISO8601())
+		}
+	}
+	if got := events[1].Recurrence; got == nil || got.Interval != Quarterly || got.Count != 4 {
+		t.Errorf("Expected quarterly recurrence of 4, got %+v", got)
+	}
+}
+
+func TestParseICS_Invalid(t *testing.T) {
+	tests := []struct {
+		target error
+		name   string
+		event  string
+	}{
+		{name: "Missing DTSTART", event: "DTEND:20240101T000000Z", target: ErrInvalidFormat},
+		{name: "Bad time", event: "DTSTART:2024-01-01", target: ErrInvalidFormat},
+		{name: "Ends before start", event: "DTSTART:20240102T000000Z\nDTEND:20240101T000000Z", target: ErrInvalidFormat},
+		{name: "Unknown TZID", event: "DTSTART;TZID=Mars/Olympus_Mons:20240101T000000", target: ErrUnknownTimezone},
+		{name: "Unsupported interval", event: "DTSTART:20240101T000000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2", target: ErrInvalidFormat},
+		{name: "BYDAY not on start", event: "DTSTART:20240101T000000Z\nRRULE:FREQ=WEEKLY;BYDAY=TU", target: ErrInvalidFormat},
+		{name: "Unsupported part", event: "DTSTART:20240101T000000Z\nRRULE:FREQ=MONTHLY;BYSETPOS=-1", target: ErrInvalidFormat},
+		{name: "Line without colon", event: "DTSTART:20240101T000000Z\nGARBAGE", target: ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseICS("BEGIN:VEVENT\n"+tt.event+"\nEND:VEVENT\n", time.UTC)
+			if !errors.Is(err, tt.target) {
+				t.Errorf("Expected %v, got %v", tt.target, err)
+			}
+		})
+	}
+
+	if _, err := ParseICS("BEGIN:VEVENT\nDTSTART:20240101T000000Z\n", time.UTC); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Expected %v for an unterminated event, got %v", ErrInvalidFormat, err)
+	}
+	if events, err := ParseICS("", nil); err != nil || len(events) != 0 {
+		t.Errorf("Expected no events, got %v, %v", events, err)
+	}
+}
@@ -0,0 +1,242 @@
+package zeit
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// isoDuration holds the components of an ISO 8601 duration (PnYnMnWnDTnHnMnS).
+// Years, months and days are calendar units; the clock part is absolute.
+type isoDuration struct {
+	years  int
+	months int
+	days   int
+	clock  time.Duration
+}
+
+// parseISODuration parses an ISO 8601 duration such as "P1Y2M10DT2H30M" or "P2W".
+// Only the seconds component may carry a fraction.
+func parseISODuration(s string) (isoDuration, error) {
+	var d isoDuration
+
+	rest, ok := strings.CutPrefix(s, "P")
+	if !ok || rest == "" || strings.HasSuffix(rest, "T") {
+		return d, fmt.Errorf("%w: ISO 8601 duration %q", ErrInvalidFormat, s)
+	}
+
+	inTime := false
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime {
+				return d, fmt.Errorf("%w: ISO 8601 duration %q", ErrInvalidFormat, s)
+			}
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+
+		end := strings.IndexFunc(rest, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.' && r != ','
+		})
+		if end <= 0 {
+			return d, fmt.Errorf("%w: ISO 8601 duration %q", ErrInvalidFormat, s)
+		}
+		number := strings.ReplaceAll(rest[:end], ",", ".")
+		designator := rest[end]
+		rest = rest[end+1:]
+
+		if inTime && designator == 'S' {
+			seconds, err := strconv.ParseFloat(number, 64)
+			if err != nil {
+				return d, fmt.Errorf("%w: ISO 8601 duration %q", ErrInvalidFormat, s)
+			}
+			d.clock += time.Duration(seconds * float64(time.Second))
+			continue
+		}
+
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return d, fmt.Errorf("%w: ISO 8601 duration %q", ErrInvalidFormat, s)
+		}
+
+		switch {
+		case !inTime && designator == 'Y':
+			d.years += n
+		case !inTime && designator == 'M':
+			d.months += n
+		case !inTime && designator == 'W':
+			d.days += 7 * n
+		case !inTime && designator == 'D':
+			d.days += n
+		case inTime && designator == 'H':
+			d.clock += time.Duration(n) * time.Hour
+		case inTime && designator == 'M':
+			d.clock += time.Duration(n) * time.Minute
+		default:
+			return d, fmt.Errorf("%w: ISO 8601 duration %q", ErrInvalidFormat, s)
+		}
+	}
+
+	return d, nil
+}
+
+// addTo applies the duration to t: calendar units in t's location, then the clock part.
+// Months clamp to the end of the month like AddSpan, so Jan 31 + P1M is Feb 29.
+func (d isoDuration) addTo(t time.Time) time.Time {
+	return shiftDate(t, 12*d.years+d.months, d.days, true).Add(d.clock)
+}
+
+// subtractFrom applies the negated duration to t: calendar units backwards in t's
+// location, then the clock part. It undoes addTo except where a month end was clamped.
+func (d isoDuration) subtractFrom(t time.Time) time.Time {
+	return shiftDate(t, -(12*d.years + d.months), -d.days, true).Add(-d.clock)
+}
+
+// shiftDate moves t by months and then days on the calendar of t's location,
+// keeping the wall-clock time and resolving DST changes like Zeit.AddDate.
+// With clamp, a day beyond the end of the target month clamps to its last day;
+// without, it overflows into the next month like time.AddDate.
+func shiftDate(t time.Time, months, days int, clamp bool) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	day := t.Day()
+	if clamp {
+		day = min(day, daysIn(first.Year(), first.Month()))
+	}
+	return localTime(first.Year(), first.Month(), day+days, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// parseISOTimestamp parses an interval endpoint: RFC3339, a local date-time
+// without offset, or a plain date, the latter two interpreted in loc.
+func parseISOTimestamp(s string, loc *time.Location) (*Zeit, error) {
+	if z, err := FromUser(s, loc); err == nil || errors.Is(err, ErrOutOfRange) {
+		return z, err
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			z := New(t, loc)
+			if err := z.Validate(); err != nil {
+				return nil, err
+			}
+			return z, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: ISO 8601 timestamp %q", ErrInvalidFormat, s)
+}
+
+// isOrdinalDate reports whether s has the shape of an ISO 8601 ordinal date,
+// "YYYY-DDD" or "YYYYDDD".
+func isOrdinalDate(s string) bool {
+	digits := s
+	if len(s) == 8 && s[4] == '-' {
+		digits = s[:4] + s[5:]
+	}
+	if len(digits) != 7 {
+		return false
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseOrdinalDate parses an ISO 8601 ordinal date such as "2024-046" or "2024046"
+// as midnight in loc. Day 366 is only valid in leap years.
+func parseOrdinalDate(s string, loc *time.Location) (time.Time, error) {
+	if !isOrdinalDate(s) {
+		return time.Time{}, fmt.Errorf("%w: ISO ordinal date %q", ErrInvalidFormat, s)
+	}
+
+	year, _ := strconv.Atoi(s[:4])
+	day, _ := strconv.Atoi(s[len(s)-3:])
+	if day < 1 || day > time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay() {
+		return time.Time{}, fmt.Errorf("%w: ISO ordinal date %q", ErrInvalidFormat, s)
+	}
+
+	return time.Date(year, time.January, day, 0, 0, 0, 0, loc), nil
+}
+
+// calendarDiff splits the span from start to end into calendar months and days
+// in start's location plus a clock remainder, counted forwards such that addTo
+// reproduces end from start, or, if end is before start, backwards such that
+// subtractFrom does. Months clamp like addTo, so Jan 31 to Mar 2 is one month to
+// Feb 29 and two days. All components are non-negative.
+func calendarDiff(start, end time.Time) isoDuration {
+	end = end.In(start.Location())
+	sign := 1
+	if end.Before(start) {
+		sign = -1
+	}
+	at := func(months, days int) time.Time {
+		return shiftDate(start, sign*months, sign*days, true)
+	}
+	beyond := func(t time.Time) bool {
+		return sign*t.Compare(end) > 0
+	}
+
+	months := sign * ((end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month()))
+	for months > 0 && beyond(at(months, 0)) {
+		months--
+	}
+
+	days := int(float64(sign) * end.Sub(at(months, 0)).Hours() / 24)
+	for days > 0 && beyond(at(months, days)) {
+		days--
+	}
+	for !beyond(at(months, days+1)) {
+		days++
+	}
+
+	return isoDuration{
+		years:  months / 12,
+		months: months % 12,
+		days:   days,
+		clock:  time.Duration(sign) * end.Sub(at(months, days)),
+	}
+}
+
+// String formats the duration in ISO 8601 form, e.g. "P1Y2M3DT4H5M6S".
+// A zero duration is "PT0S".
+func (d isoDuration) String() string {
+	var b strings.Builder
+	b.WriteByte('P')
+
+	writeComponent := func(n int64, designator byte) {
+		if n != 0 {
+			b.WriteString(strconv.FormatInt(n, 10))
+			b.WriteByte(designator)
+		}
+	}
+	writeComponent(int64(d.years), 'Y')
+	writeComponent(int64(d.months), 'M')
+	writeComponent(int64(d.days), 'D')
+
+	if d.clock == 0 {
+		if b.Len() == 1 {
+			b.WriteString("T0S")
+		}
+		return b.String()
+	}
+
+	b.WriteByte('T')
+	hours := d.clock / time.Hour
+	minutes := (d.clock % time.Hour) / time.Minute
+	seconds := d.clock % time.Minute
+	writeComponent(int64(hours), 'H')
+	writeComponent(int64(minutes), 'M')
+	if seconds != 0 {
+		whole := seconds / time.Second
+		b.WriteString(strconv.FormatInt(int64(whole), 10))
+		if frac := seconds % time.Second; frac != 0 {
+			b.WriteByte('.')
+			b.WriteString(strings.TrimRight(fmt.Sprintf("%09d", int64(frac)), "0"))
+		}
+		b.WriteByte('S')
+	}
+
+	return b.String()
+}
@@ -0,0 +1,125 @@
+package zeit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseISODuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected isoDuration
+		wantErr  bool
+	}{
+		{"Months", "P1M", isoDuration{months: 1}, false},
+		{"Full", "P1Y2M10DT2H30M15S", isoDuration{years: 1, months: 2, days: 10, clock: 2*time.Hour + 30*time.Minute + 15*time.Second}, false},
+		{"Weeks", "P2W", isoDuration{days: 14}, false},
+		{"Time only", "PT36H", isoDuration{clock: 36 * time.Hour}, false},
+		{"Fractional seconds", "PT1.5S", isoDuration{clock: 1500 * time.Millisecond}, false},
+		{"Comma fraction", "PT0,25S", isoDuration{clock: 250 * time.Millisecond}, false},
+		{"Minutes vs months", "P1MT1M", isoDuration{months: 1, clock: time.Minute}, false},
+		{"Missing P", "1D", isoDuration{}, true},
+		{"Empty", "P", isoDuration{}, true},
+		{"Dangling T", "P1DT", isoDuration{}, true},
+		{"Hours in date part", "P1H", isoDuration{}, true},
+		{"Fractional days", "P1.5D", isoDuration{}, true},
+		{"Missing number", "PD", isoDuration{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseISODuration(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseISODuration(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestISODuration_String(t *testing.T) {
+	tests := []struct {
+		expected string
+		input    isoDuration
+	}{
+		{"PT0S", isoDuration{}},
+		{"P1M", isoDuration{months: 1}},
+		{"P1Y2M10DT2H30M15S", isoDuration{years: 1, months: 2, days: 10, clock: 2*time.Hour + 30*time.Minute + 15*time.Second}},
+		{"PT36H", isoDuration{clock: 36 * time.Hour}},
+		{"PT1.5S", isoDuration{clock: 1500 * time.Millisecond}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if got := tt.input.String(); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCalendarDiff(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		start    time.Time
+		end      time.Time
+		name     string
+		expected string
+	}{
+		{name: "Whole month", start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), end: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), expected: "P1M"},
+		{name: "Year and days", start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), end: time.Date(2025, 1, 11, 6, 0, 0, 0, time.UTC), expected: "P1Y10DT6H"},
+		{name: "Short February", start: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), end: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), expected: "P1M"},
+		{name: "Same instant", start: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), end: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), expected: "PT0S"},
+		{name: "Across DST", start: time.Date(2024, 3, 30, 0, 0, 0, 0, berlin), end: time.Date(2024, 4, 1, 0, 0, 0, 0, berlin), expected: "P2D"},
+		{name: "Month end clamps", start: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), end: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), expected: "P1M"},
+		{name: "Past a clamped month end", start: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), end: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), expected: "P1M2D"},
+		{name: "Short month to long month", start: time.Date(2024, 2, 29, 9, 0, 0, 0, berlin), end: time.Date(2024, 3, 31, 9, 0, 0, 0, berlin), expected: "P1M2D"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := calendarDiff(tt.start, tt.end)
+			if got := d.String(); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+			if !d.addTo(tt.start).Equal(tt.end) {
+				t.Errorf("addTo should reproduce the end: got %v", d.addTo(tt.start))
+			}
+		})
+	}
+}
+
+func TestFromUser_OrdinalDate(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		expected time.Time
+		input    string
+		wantErr  bool
+	}{
+		{input: "2024-046", expected: time.Date(2024, 2, 15, 0, 0, 0, 0, berlin)},
+		{input: "2024046", expected: time.Date(2024, 2, 15, 0, 0, 0, 0, berlin)},
+		{input: "2024-366", expected: time.Date(2024, 12, 31, 0, 0, 0, 0, berlin)},
+		{input: "2023-001", expected: time.Date(2023, 1, 1, 0, 0, 0, 0, berlin)},
+		{input: "2023-366", wantErr: true},
+		{input: "2024-000", wantErr: true},
+		{input: "2024-46", wantErr: true},
+		{input: "2024-4a6", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			z, err := FromUser(tt.input, berlin)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromUser() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !z.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected.UTC(), z.instant)
+			}
+		})
+	}
+}
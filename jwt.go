@@ -0,0 +1,52 @@
+package zeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// NumericDate returns the Zeit as an RFC 7519 NumericDate, whole seconds since the
+// Unix epoch, for the iat, nbf and exp claims of a JSON Web Token. Fractions of a
+// second are truncated. Independent of the Zeit's timezone.
+func (z *Zeit) NumericDate() json.Number {
+	return json.Number(strconv.FormatInt(z.instant.Unix(), 10))
+}
+
+// FromNumericDate creates a Zeit in loc from an RFC 7519 NumericDate, as decoded
+// with json.Decoder.UseNumber. Non-integer values such as "1705314600.5" are
+// allowed by the RFC and read with FromEpochFloat.
+// Returns ErrInvalidFormat for values that are not finite numbers and ErrOutOfRange
+// for times that don't fit a Zeit or lie outside the configured valid range.
+func FromNumericDate(n json.Number, loc *time.Location) (*Zeit, error) {
+	seconds, err := n.Int64()
+	if err != nil {
+		f, err := n.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("%w: numeric date %q", ErrInvalidFormat, n.String())
+		}
+		return FromEpochFloat(f, loc)
+	}
+
+	z := FromDatabase(seconds, loc)
+	if err := z.Validate(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsWithinValidity reports whether z lies within the validity window of a token
+// issued at issuedAt that expires at expiresAt, allowing skew for clock differences
+// between issuer and verifier: issuedAt-skew <= z < expiresAt+skew, following
+// RFC 7519 where a token is no longer valid at its exp. Pass the nbf claim as
+// issuedAt when the token has one. A nil bound leaves that side open.
+func (z *Zeit) IsWithinValidity(issuedAt, expiresAt *Zeit, skew time.Duration) bool {
+	if issuedAt != nil && z.instant.Before(issuedAt.instant.Add(-skew)) {
+		return false
+	}
+	if expiresAt != nil && !z.instant.Before(expiresAt.instant.Add(skew)) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+package zeit
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNumericDate(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := New(time.Date(2024, 1, 15, 10, 30, 0, 900000000, time.UTC), berlin)
+
+	if got := z.NumericDate(); got != "1705314600" {
+		t.Errorf("Expected 1705314600, got %s", got)
+	}
+
+	data, err := json.Marshal(map[string]json.Number{"exp": z.NumericDate()})
+	if err != nil || string(data) != `{"exp":1705314600}` {
+		t.Errorf("Expected {\"exp\":1705314600}, got %s (%v)", data, err)
+	}
+}
+
+func TestFromNumericDate(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		expected time.Time
+		input    json.Number
+	}{
+		{input: "1705314600", expected: time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)},
+		{input: "1705314600.5", expected: time.Date(2024, 1, 15, 10, 30, 0, 500000000, time.UTC)},
+		{input: "1.7053146e9", expected: time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)},
+		{input: "0", expected: time.Unix(0, 0)},
+		{input: "-86400", expected: time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.input), func(t *testing.T) {
+			z, err := FromNumericDate(tt.input, berlin)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !z.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, z.instant)
+			}
+			if z.Location() != berlin {
+				t.Error("Result should be in the given location")
+			}
+		})
+	}
+}
+
+func TestFromNumericDate_Decoded(t *testing.T) {
+	var claims struct {
+		IssuedAt  json.Number `json:"iat"`
+		ExpiresAt json.Number `json:"exp"`
+	}
+	dec := json.NewDecoder(strings.NewReader(`{"iat":1705314600,"exp":1705318200}`))
+	dec.UseNumber()
+	if err := dec.Decode(&claims); err != nil {
+		t.Fatalf("Decode error: %v", err)
+	}
+
+	iat, err := FromNumericDate(claims.IssuedAt, time.UTC)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	exp, err := FromNumericDate(claims.ExpiresAt, time.UTC)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if d := exp.instant.Sub(iat.instant); d != time.Hour {
+		t.Errorf("Expected 1h validity, got %v", d)
+	}
+}
+
+func TestFromNumericDate_Invalid(t *testing.T) {
+	for _, input := range []json.Number{"", "soon", "NaN", "Inf", "-Inf", "2024-01-15"} {
+		if _, err := FromNumericDate(input, time.UTC); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Expected %v for %q, got %v", ErrInvalidFormat, input, err)
+		}
+	}
+}
+
+func TestFromNumericDate_ValidRange(t *testing.T) {
+	earliest, latest := ingestionRange()
+	withValidRange(t, earliest, latest)
+
+	if _, err := FromNumericDate("99999999999", time.UTC); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Expected ErrOutOfRange, got %v", err)
+	}
+}
+
+func TestFromNumericDate_OutOfRange(t *testing.T) {
+	for _, input := range []json.Number{"1e300", "-1e300", "9223372036854775808"} {
+		if _, err := FromNumericDate(input, time.UTC); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("Expected %v for %q, got %v", ErrOutOfRange, input, err)
+		}
+	}
+}
+
+func TestIsWithinValidity(t *testing.T) {
+	iat := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), time.UTC)
+	exp := New(time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		now      *Zeit
+		iat      *Zeit
+		exp      *Zeit
+		name     string
+		skew     time.Duration
+		expected bool
+	}{
+		{name: "Within", now: iat.Add(30 * time.Minute), iat: iat, exp: exp, expected: true},
+		{name: "At issue", now: iat, iat: iat, exp: exp, expected: true},
+		{name: "At expiry", now: exp, iat: iat, exp: exp, expected: false},
+		{name: "Before issue", now: iat.Add(-time.Second), iat: iat, exp: exp, expected: false},
+		{name: "Before issue within skew", now: iat.Add(-30 * time.Second), iat: iat, exp: exp, skew: time.Minute, expected: true},
+		{name: "After expiry within skew", now: exp.Add(59 * time.Second), iat: iat, exp: exp, skew: time.Minute, expected: true},
+		{name: "After expiry beyond skew", now: exp.Add(time.Minute), iat: iat, exp: exp, skew: time.Minute, expected: false},
+		{name: "No issue time", now: iat.Add(-24 * time.Hour), exp: exp, expected: true},
+		{name: "No expiry", now: exp.Add(24 * time.Hour), iat: iat, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.now.IsWithinValidity(tt.iat, tt.exp, tt.skew); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
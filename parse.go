@@ -0,0 +1,183 @@
+package zeit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateOrder tells ParseNumericDate the order of day, month and year.
+type DateOrder int
+
+const (
+	// DateOrderUnknown accepts only dates whose order is evident: a leading
+	// four-digit year, a day above 12, or day and month being equal.
+	DateOrderUnknown DateOrder = iota
+	// DMY reads "15/01/2024" as day, month, year, as in most of Europe.
+	DMY
+	// MDY reads "01/15/2024" as month, day, year, as in the US.
+	MDY
+	// YMD reads "2024/01/15" as year, month, day.
+	YMD
+)
+
+// ParseNumericDate leniently parses a numeric date such as "15/01/2024",
+// "01.15.2024" or "2024-1-15" as midnight in loc. Fields are separated by "/",
+// "." or "-", used consistently; day and month may have one or two digits.
+// Pass the DateOrder agreed with the data source. With DateOrderUnknown, dates
+// that read differently as DMY and MDY, such as "01/02/2024", fail with
+// ErrAmbiguousDate instead of being guessed. Two-digit years are always rejected
+// with ErrAmbiguousDate. Malformed dates and orders other than the DateOrder
+// constants fail with ErrInvalidFormat. A nil loc defaults to UTC.
+func ParseNumericDate(s string, order DateOrder, loc *time.Location) (*Zeit, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	invalid := fmt.Errorf("%w: numeric date %q", ErrInvalidFormat, s)
+
+	sep := strings.IndexAny(s, "/.-")
+	if sep < 0 {
+		return nil, invalid
+	}
+	fields := strings.Split(s, s[sep:sep+1])
+	if len(fields) != 3 {
+		return nil, invalid
+	}
+
+	nums := make([]int, 3)
+	for i, f := range fields {
+		if f == "" || len(f) > 4 || strings.Trim(f, "0123456789") != "" {
+			return nil, invalid
+		}
+		nums[i], _ = strconv.Atoi(f)
+	}
+
+	if order == DateOrderUnknown {
+		switch {
+		case len(fields[0]) == 4:
+			order = YMD
+		case nums[0] > 12 && nums[1] <= 12:
+			order = DMY
+		case nums[1] > 12 && nums[0] <= 12:
+			order = MDY
+		case nums[0] == nums[1]:
+			order = DMY
+		default:
+			return nil, fmt.Errorf("%w: %q could be day/month or month/day; pass DMY or MDY", ErrAmbiguousDate, s)
+		}
+	}
+
+	// Field indexes of day, month and year
+	var d, m, y int
+	switch order {
+	case DMY:
+		d, m, y = 0, 1, 2
+	case MDY:
+		d, m, y = 1, 0, 2
+	case YMD:
+		d, m, y = 2, 1, 0
+	default:
+		return nil, fmt.Errorf("%w: unknown date order %d", ErrInvalidFormat, int(order))
+	}
+	day, month, year := nums[d], nums[m], nums[y]
+
+	if len(fields[y]) == 2 {
+		return nil, fmt.Errorf("%w: two-digit year in %q", ErrAmbiguousDate, s)
+	}
+	if len(fields[y]) != 4 || len(fields[d]) > 2 || len(fields[m]) > 2 {
+		return nil, invalid
+	}
+	if month < 1 || month > 12 || day < 1 || day > daysIn(year, time.Month(month)) {
+		return nil, invalid
+	}
+
+	z := New(time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc), loc)
+	if err := z.Validate(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// ParseLocalized parses a date with a written month name, such as
+// "15 January 2024", "January 15th, 2024" or "15. Januar 2024", as midnight in loc.
+// Month names match locale's Months or ShortMonths, ignoring case and a trailing
+// "."; the day may be plain or written with locale's ordinal. The year comes last
+// and has four digits. A leading weekday name, as written by FormatLocalized, is
+// accepted if it matches the date. Fields are separated by spaces, commas or "-".
+// A nil locale uses English and a nil loc defaults to UTC.
+func ParseLocalized(s string, locale *Locale, loc *time.Location) (*Zeit, error) {
+	if locale == nil {
+		locale = English
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	invalid := fmt.Errorf("%w: localized date %q", ErrInvalidFormat, s)
+
+	fields := strings.FieldsFunc(s, func(r rune) bool { return r == ' ' || r == ',' || r == '-' })
+	weekday := -1
+	if len(fields) == 4 {
+		weekday = matchName(fields[0], locale.Weekdays[:], locale.ShortWeekdays[:])
+		if weekday < 0 {
+			return nil, invalid
+		}
+		fields = fields[1:]
+	}
+	if len(fields) != 3 || len(fields[2]) != 4 || strings.Trim(fields[2], "0123456789") != "" {
+		return nil, invalid
+	}
+	year, _ := strconv.Atoi(fields[2])
+
+	month := matchName(fields[1], locale.Months[:], locale.ShortMonths[:])
+	day := localizedDay(fields[0], locale)
+	if month < 0 {
+		month = matchName(fields[0], locale.Months[:], locale.ShortMonths[:])
+		day = localizedDay(fields[1], locale)
+	}
+	if month < 0 || day < 1 || day > daysIn(year, time.Month(month+1)) {
+		return nil, invalid
+	}
+
+	t := time.Date(year, time.Month(month+1), day, 0, 0, 0, 0, loc)
+	if weekday >= 0 && t.Weekday() != time.Weekday(weekday) {
+		return nil, fmt.Errorf("%w: %q names the wrong weekday", ErrInvalidFormat, s)
+	}
+
+	z := New(t, loc)
+	if err := z.Validate(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// matchName returns the index of s in full or short, ignoring case and a
+// trailing ".", or -1 if it matches neither.
+func matchName(s string, full, short []string) int {
+	s = strings.TrimSuffix(s, ".")
+	for _, names := range [][]string{full, short} {
+		for i, name := range names {
+			if strings.EqualFold(s, strings.TrimSuffix(name, ".")) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// localizedDay parses a day of month written as one or two digits, optionally
+// followed by ".", or as locale's ordinal such as "15th". Returns 0 otherwise.
+func localizedDay(s string, locale *Locale) int {
+	digits := s
+	if i := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' }); i >= 0 {
+		digits = s[:i]
+	}
+	if digits == "" || len(digits) > 2 {
+		return 0
+	}
+	day, _ := strconv.Atoi(digits)
+	if s != digits && s != digits+"." && !strings.EqualFold(s, locale.Ordinal(day)) {
+		return 0
+	}
+	return day
+}
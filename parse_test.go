@@ -0,0 +1,153 @@
+package zeit
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseNumericDate(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		expected time.Time
+		input    string
+		name     string
+		order    DateOrder
+	}{
+		{name: "DMY slashes", input: "01/02/2024", order: DMY, expected: time.Date(2024, 2, 1, 0, 0, 0, 0, berlin)},
+		{name: "MDY slashes", input: "01/02/2024", order: MDY, expected: time.Date(2024, 1, 2, 0, 0, 0, 0, berlin)},
+		{name: "DMY dots", input: "15.1.2024", order: DMY, expected: time.Date(2024, 1, 15, 0, 0, 0, 0, berlin)},
+		{name: "YMD dashes", input: "2024-1-15", order: YMD, expected: time.Date(2024, 1, 15, 0, 0, 0, 0, berlin)},
+		{name: "Unknown, day above 12 first", input: "15/01/2024", order: DateOrderUnknown, expected: time.Date(2024, 1, 15, 0, 0, 0, 0, berlin)},
+		{name: "Unknown, day above 12 second", input: "01/15/2024", order: DateOrderUnknown, expected: time.Date(2024, 1, 15, 0, 0, 0, 0, berlin)},
+		{name: "Unknown, equal fields", input: "03/03/2024", order: DateOrderUnknown, expected: time.Date(2024, 3, 3, 0, 0, 0, 0, berlin)},
+		{name: "Unknown, leading year", input: "2024/02/01", order: DateOrderUnknown, expected: time.Date(2024, 2, 1, 0, 0, 0, 0, berlin)},
+		{name: "Leap day", input: "29.02.2024", order: DMY, expected: time.Date(2024, 2, 29, 0, 0, 0, 0, berlin)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z, err := ParseNumericDate(tt.input, tt.order, berlin)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !z.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, z.Time())
+			}
+		})
+	}
+}
+
+func TestParseNumericDate_Invalid(t *testing.T) {
+	tests := []struct {
+		target error
+		input  string
+		name   string
+		order  DateOrder
+	}{
+		{name: "Ambiguous without order", input: "01/02/2024", order: DateOrderUnknown, target: ErrAmbiguousDate},
+		{name: "Two-digit year", input: "15/01/24", order: DMY, target: ErrAmbiguousDate},
+		{name: "Month out of range", input: "01/15/2024", order: DMY, target: ErrInvalidFormat},
+		{name: "No leap day", input: "29.02.2023", order: DMY, target: ErrInvalidFormat},
+		{name: "Mixed separators", input: "15/01.2024", order: DMY, target: ErrInvalidFormat},
+		{name: "Too many fields", input: "15/01/2024/1", order: DMY, target: ErrInvalidFormat},
+		{name: "Signed field", input: "+1/01/2024", order: DMY, target: ErrInvalidFormat},
+		{name: "Padded day", input: "015/01/2024", order: DMY, target: ErrInvalidFormat},
+		{name: "No separator", input: "15012024", order: DMY, target: ErrInvalidFormat},
+		{name: "Unknown order", input: "15/01/2024", order: DateOrder(9)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseNumericDate(tt.input, tt.order, time.UTC)
+			if err == nil {
+				t.Fatal("Expected error")
+			}
+			if tt.target != nil && !errors.Is(err, tt.target) {
+				t.Errorf("Expected %v, got %v", tt.target, err)
+			}
+		})
+	}
+}
+
+func TestParseLocalized(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	jan15 := time.Date(2024, 1, 15, 0, 0, 0, 0, berlin)
+
+	tests := []struct {
+		expected time.Time
+		locale   *Locale
+		input    string
+		name     string
+	}{
+		{name: "English day first", input: "15 January 2024", locale: English, expected: jan15},
+		{name: "English month first", input: "January 15, 2024", locale: English, expected: jan15},
+		{name: "English ordinal", input: "January 15th, 2024", locale: English, expected: jan15},
+		{name: "English short month", input: "15 Jan 2024", locale: English, expected: jan15},
+		{name: "English dashes", input: "15-Jan-2024", locale: English, expected: jan15},
+		{name: "English case-insensitive", input: "15 JANUARY 2024", locale: English, expected: jan15},
+		{name: "English weekday", input: "Monday, January 15th, 2024", locale: English, expected: jan15},
+		{name: "German", input: "15 Januar 2024", locale: German, expected: jan15},
+		{name: "German ordinal", input: "15. Januar 2024", locale: German, expected: jan15},
+		{name: "German umlaut", input: "1. März 2024", locale: German, expected: time.Date(2024, 3, 1, 0, 0, 0, 0, berlin)},
+		{name: "German short month with dot", input: "3. Sept. 2024", locale: German, expected: time.Date(2024, 9, 3, 0, 0, 0, 0, berlin)},
+		{name: "German short month without dot", input: "3 Sept 2024", locale: German, expected: time.Date(2024, 9, 3, 0, 0, 0, 0, berlin)},
+		{name: "German weekday", input: "Montag, 15. Januar 2024", locale: German, expected: jan15},
+		{name: "Nil locale is English", input: "29 February 2024", locale: nil, expected: time.Date(2024, 2, 29, 0, 0, 0, 0, berlin)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z, err := ParseLocalized(tt.input, tt.locale, berlin)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !z.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, z.Time())
+			}
+		})
+	}
+}
+
+func TestParseLocalized_RoundTrip(t *testing.T) {
+	z := New(time.Date(2024, 10, 3, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	for _, locale := range []*Locale{English, German} {
+		s := z.FormatLocalized("Monday, 2nd January 2006", locale)
+		parsed, err := ParseLocalized(s, locale, time.UTC)
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %v", s, err)
+		}
+		if !parsed.Equal(z) {
+			t.Errorf("Expected %v, got %v", z.ToUser(), parsed.ToUser())
+		}
+	}
+}
+
+func TestParseLocalized_Invalid(t *testing.T) {
+	tests := []struct {
+		locale *Locale
+		input  string
+		name   string
+	}{
+		{name: "Wrong locale", input: "15 Januar 2024", locale: English},
+		{name: "Unknown month", input: "15 Smarch 2024", locale: English},
+		{name: "No leap day", input: "29 February 2023", locale: English},
+		{name: "Two-digit year", input: "15 January 24", locale: English},
+		{name: "Year first", input: "2024 January 15", locale: English},
+		{name: "Wrong ordinal", input: "January 15nd, 2024", locale: English},
+		{name: "Wrong weekday", input: "Tuesday, 15 January 2024", locale: English},
+		{name: "Missing day", input: "January 2024", locale: English},
+		{name: "Day zero", input: "0 January 2024", locale: English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseLocalized(tt.input, tt.locale, time.UTC)
+			if !errors.Is(err, ErrInvalidFormat) {
+				t.Errorf("Expected %v, got %v", ErrInvalidFormat, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package zeit
+
+import "time"
+
+// PayFrequency represents how often wages are paid.
+type PayFrequency int
+
+const (
+	// SemiMonthly pays twice a month, for the 1st-15th and the 16th to month end.
+	SemiMonthly PayFrequency = iota
+	// BiWeekly pays every 14 days, counted from the anchor date.
+	BiWeekly
+)
+
+// Payroll generates pay periods and their pay dates.
+// Pay dates fall on the last day of each period and move to the previous
+// business day of Calendar when that day is a weekend or holiday.
+type Payroll struct {
+	Anchor    *Zeit
+	Calendar  *Calendar
+	Frequency PayFrequency
+}
+
+// PayPeriod pairs a pay period with the date its wages are paid.
+type PayPeriod struct {
+	Period  *Period
+	PayDate *Zeit
+}
+
+// NewPayroll creates a Payroll. For BiWeekly, anchor's date starts the first period;
+// for SemiMonthly, the first period is the half-month containing anchor.
+// Periods and pay dates use anchor's timezone.
+func NewPayroll(frequency PayFrequency, anchor *Zeit, cal *Calendar) *Payroll {
+	return &Payroll{
+		Anchor:    anchor,
+		Calendar:  cal,
+		Frequency: frequency,
+	}
+}
+
+// Periods generates count consecutive pay periods, each from local midnight to
+// local midnight, starting with the first period defined by the anchor.
+// Returns ErrNoBusinessDay if a pay date can't be moved to a business day, as
+// behind an open-ended absence.
+func (p *Payroll) Periods(count int) ([]*PayPeriod, error) {
+	if count <= 0 {
+		return []*PayPeriod{}, nil
+	}
+
+	loc := p.Anchor.location
+	start := startOfDay(p.Anchor.Time())
+	if p.Frequency == SemiMonthly {
+		day := 1
+		if start.Day() > 15 {
+			day = 16
+		}
+		start = time.Date(start.Year(), start.Month(), day, 0, 0, 0, 0, loc)
+	}
+
+	periods := make([]*PayPeriod, count)
+
+	for i := range count {
+		var end time.Time
+
+		switch p.Frequency {
+		case BiWeekly:
+			end = start.AddDate(0, 0, 14)
+		default:
+			if start.Day() == 1 {
+				end = time.Date(start.Year(), start.Month(), 16, 0, 0, 0, 0, loc)
+			} else {
+				end = time.Date(start.Year(), start.Month()+1, 1, 0, 0, 0, 0, loc)
+			}
+		}
+
+		payDay, err := p.Calendar.previousBusinessDay(end.AddDate(0, 0, -1))
+		if err != nil {
+			return nil, err
+		}
+
+		periods[i] = &PayPeriod{
+			Period: &Period{
+				StartsAt: New(start, loc),
+				EndsAt:   New(end, loc),
+			},
+			PayDate: New(payDay, loc),
+		}
+
+		start = end
+	}
+
+	return periods, nil
+}
@@ -0,0 +1,91 @@
+package zeit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPayroll_SemiMonthly(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	anchor := New(time.Date(2024, 6, 20, 10, 0, 0, 0, berlin), berlin)
+
+	periods, err := NewPayroll(SemiMonthly, anchor, nil).Periods(3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(periods) != 3 {
+		t.Fatalf("Expected 3 pay periods, got %d", len(periods))
+	}
+
+	tests := []struct {
+		start time.Time
+		end   time.Time
+		pay   time.Time
+	}{
+		// Jun 30, 2024 is a Sunday: pay on Friday Jun 28
+		{time.Date(2024, 6, 16, 0, 0, 0, 0, berlin), time.Date(2024, 7, 1, 0, 0, 0, 0, berlin), time.Date(2024, 6, 28, 0, 0, 0, 0, berlin)},
+		{time.Date(2024, 7, 1, 0, 0, 0, 0, berlin), time.Date(2024, 7, 16, 0, 0, 0, 0, berlin), time.Date(2024, 7, 15, 0, 0, 0, 0, berlin)},
+		{time.Date(2024, 7, 16, 0, 0, 0, 0, berlin), time.Date(2024, 8, 1, 0, 0, 0, 0, berlin), time.Date(2024, 7, 31, 0, 0, 0, 0, berlin)},
+	}
+
+	for i, tt := range tests {
+		p := periods[i]
+		if !p.Period.StartsAt.Time().Equal(tt.start) || !p.Period.EndsAt.Time().Equal(tt.end) {
+			t.Errorf("Period %d: expected %v -> %v, got %v -> %v", i, tt.start, tt.end, p.Period.StartsAt.Time(), p.Period.EndsAt.Time())
+		}
+		if !p.PayDate.Time().Equal(tt.pay) {
+			t.Errorf("Period %d pay date: expected %v, got %v", i, tt.pay, p.PayDate.Time())
+		}
+		if p.PayDate.Location() != berlin {
+			t.Errorf("Period %d pay date should use the anchor timezone", i)
+		}
+	}
+}
+
+func TestPayroll_BiWeekly(t *testing.T) {
+	// Periods run Monday to Sunday, twice over: Jan 1 -> Jan 15
+	anchor := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	periods, err := NewPayroll(BiWeekly, anchor, nil).Periods(2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedEnd := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	if !periods[0].Period.EndsAt.instant.Equal(expectedEnd) {
+		t.Errorf("Expected end %v, got %v", expectedEnd, periods[0].Period.EndsAt.instant)
+	}
+	// Last day is Sunday Jan 14: pay on Friday Jan 12
+	expectedPay := time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC)
+	if !periods[0].PayDate.instant.Equal(expectedPay) {
+		t.Errorf("Expected pay date %v, got %v", expectedPay, periods[0].PayDate.instant)
+	}
+	if !periods[1].Period.StartsAt.Equal(periods[0].Period.EndsAt) {
+		t.Error("Pay periods should be contiguous")
+	}
+}
+
+func TestPayroll_HolidayAdjustment(t *testing.T) {
+	// Dec 31, 2024 (Tuesday) is a company holiday: pay on Monday Dec 30
+	cal := NewCalendar(Holiday{Name: "New Year's Eve", Month: time.December, Day: 31})
+	anchor := New(time.Date(2024, 12, 16, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	periods, err := NewPayroll(SemiMonthly, anchor, cal).Periods(1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)
+	if !periods[0].PayDate.instant.Equal(expected) {
+		t.Errorf("Expected pay date %v, got %v", expected, periods[0].PayDate.instant)
+	}
+}
+
+func TestPayroll_ZeroCount(t *testing.T) {
+	anchor := Now(time.UTC)
+
+	if periods, _ := NewPayroll(BiWeekly, anchor, nil).Periods(0); len(periods) != 0 {
+		t.Errorf("Expected 0 pay periods, got %d", len(periods))
+	}
+}
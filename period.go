@@ -0,0 +1,344 @@
+package zeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// IsOpen reports whether the period has no end yet.
+func (p *Period) IsOpen() bool {
+	return p.EndsAt == nil
+}
+
+// IsEmpty reports whether the period starts and ends at the same instant.
+// Empty periods are valid but contain no instants.
+func (p *Period) IsEmpty() bool {
+	return p.EndsAt != nil && p.StartsAt.Equal(p.EndsAt)
+}
+
+// Equal reports whether p and other start and end at the same instants.
+// Two open-ended periods are equal if they start at the same instant.
+func (p *Period) Equal(other *Period) bool {
+	if !p.StartsAt.Equal(other.StartsAt) {
+		return false
+	}
+	if p.EndsAt == nil || other.EndsAt == nil {
+		return p.EndsAt == nil && other.EndsAt == nil
+	}
+	return p.EndsAt.Equal(other.EndsAt)
+}
+
+// IsValid reports whether the period does not end before it starts.
+// Reversed periods produce negative durations in invoice math.
+// Empty and open-ended periods are valid.
+func (p *Period) IsValid() bool {
+	return p.EndsAt == nil || !p.EndsAt.Before(p.StartsAt)
+}
+
+// Clone returns a deep copy of the period, including its start and end,
+// so editing the copy's fields never affects the original. Returns nil for a nil Period.
+func (p *Period) Clone() *Period {
+	if p == nil {
+		return nil
+	}
+	return &Period{
+		StartsAt: p.StartsAt.Clone(),
+		EndsAt:   p.EndsAt.Clone(),
+		Kind:     p.Kind,
+		partial:  p.partial,
+	}
+}
+
+// Normalize returns a new Period with StartsAt and EndsAt swapped if reversed.
+func (p *Period) Normalize() *Period {
+	if p.EndsAt != nil && p.EndsAt.Before(p.StartsAt) {
+		return &Period{StartsAt: p.EndsAt, EndsAt: p.StartsAt, Kind: p.Kind, partial: p.partial}
+	}
+	return &Period{StartsAt: p.StartsAt, EndsAt: p.EndsAt, Kind: p.Kind, partial: p.partial}
+}
+
+// SnapToLocalDays returns a new Period expanded to whole local days in the
+// timezone of StartsAt: the start moves back to local midnight and the end
+// forward to the next local midnight unless it already is one. Daily usage
+// reports use it to cover full local days. Both endpoints end up in StartsAt's
+// timezone. Reversed periods are normalized first, open-ended periods stay open.
+// Kind is kept. Returns nil for a nil Period.
+func (p *Period) SnapToLocalDays() *Period {
+	if p == nil {
+		return nil
+	}
+
+	n := p.Normalize()
+	loc := n.StartsAt.location
+	snapped := &Period{StartsAt: n.StartsAt.StartOfDay(), Kind: p.Kind}
+	if n.EndsAt != nil {
+		end := n.EndsAt.In(loc)
+		snapped.EndsAt = end.StartOfDay()
+		if !snapped.EndsAt.Equal(end) {
+			snapped.EndsAt = end.addLocalDays(1)
+		}
+	}
+	return snapped
+}
+
+// Overlaps reports whether p and other share at least one instant.
+func (p *Period) Overlaps(other *Period) bool {
+	return p.Overlap(other) != nil
+}
+
+// Overlap returns the intersection of p and other, or nil if they don't overlap.
+// The result is open-ended only if both periods are open-ended.
+func (p *Period) Overlap(other *Period) *Period {
+	start := p.StartsAt
+	if other.StartsAt.After(start) {
+		start = other.StartsAt
+	}
+
+	end := p.EndsAt
+	if end == nil || (other.EndsAt != nil && other.EndsAt.Before(end)) {
+		end = other.EndsAt
+	}
+
+	if end != nil && !start.Before(end) {
+		return nil
+	}
+	return &Period{StartsAt: start, EndsAt: end}
+}
+
+// FractionOf returns the share of container covered by p, from 0 to 1, for
+// prorating shared costs: a tenant active for 10 days of a 30-day month gets a third.
+// Reversed periods are normalized first. Open-ended periods are measured until now,
+// like Duration. Returns 0 if they don't overlap or container is empty.
+func (p *Period) FractionOf(container *Period) float64 {
+	c := container.Normalize()
+	overlap := p.Normalize().Overlap(c)
+	if overlap == nil {
+		return 0
+	}
+
+	total := c.Duration()
+	if total <= 0 {
+		return 0
+	}
+	return min(max(float64(overlap.Duration())/float64(total), 0), 1)
+}
+
+// ParsePeriod parses an ISO 8601 time interval into a Period in the given location.
+// Accepted forms are start/end, start/duration and duration/end, for example
+// "2024-01-01/2024-02-01", "2024-01-01T00:00:00Z/P1M" or "P1D/2024-01-02".
+// Endpoints without an offset are interpreted in loc. An end of ".." yields an
+// open-ended period. Months clamp to the end of the month like AddSpan, so
+// "2024-01-31/P1M" ends on Feb 29.
+// Returns ErrInvalidFormat for malformed intervals and intervals that end before
+// they start, and ErrOutOfRange for endpoints, given or derived from a duration,
+// outside the configured valid range.
+func ParsePeriod(s string, loc *time.Location) (*Period, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	first, second, ok := strings.Cut(s, "/")
+	if !ok || strings.Contains(second, "/") {
+		return nil, fmt.Errorf("%w: ISO 8601 interval %q", ErrInvalidFormat, s)
+	}
+
+	var start, end *Zeit
+
+	switch {
+	case strings.HasPrefix(first, "P") && strings.HasPrefix(second, "P"):
+		return nil, fmt.Errorf("%w: ISO 8601 interval %q", ErrInvalidFormat, s)
+	case strings.HasPrefix(first, "P"):
+		d, err := parseISODuration(first)
+		if err != nil {
+			return nil, err
+		}
+		end, err = parseISOTimestamp(second, loc)
+		if err != nil {
+			return nil, err
+		}
+		start = New(d.subtractFrom(end.Time()), loc)
+		if err := start.Validate(); err != nil {
+			return nil, err
+		}
+	default:
+		var err error
+		start, err = parseISOTimestamp(first, loc)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case second == "..":
+		case strings.HasPrefix(second, "P"):
+			d, err := parseISODuration(second)
+			if err != nil {
+				return nil, err
+			}
+			end = New(d.addTo(start.Time()), loc)
+			if err := end.Validate(); err != nil {
+				return nil, err
+			}
+		default:
+			end, err = parseISOTimestamp(second, loc)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	p := &Period{StartsAt: start, EndsAt: end}
+	if !p.IsValid() {
+		return nil, fmt.Errorf("%w: interval %q ends before it starts", ErrInvalidFormat, s)
+	}
+	return p, nil
+}
+
+// ISO8601 formats the period as an ISO 8601 start/end interval, e.g.
+// "2024-01-01T00:00:00Z/2024-02-01T00:00:00Z", with each endpoint in its own
+// timezone. Fractional seconds are kept, so sub-second periods round-trip through
+// ParsePeriod. Open-ended periods end in "..".
+func (p *Period) ISO8601() string {
+	if p.EndsAt == nil {
+		return p.StartsAt.Format(time.RFC3339Nano) + "/.."
+	}
+	return p.StartsAt.Format(time.RFC3339Nano) + "/" + p.EndsAt.Format(time.RFC3339Nano)
+}
+
+// ISO8601Duration formats the period as an ISO 8601 start/duration interval,
+// e.g. "2024-01-01T00:00:00Z/P1M". Months and days are measured on the calendar
+// of StartsAt's timezone, so parse the result with that location to round-trip.
+// Open-ended periods end in "..", reversed periods are normalized first.
+func (p *Period) ISO8601Duration() string {
+	n := p.Normalize()
+	if n.EndsAt == nil {
+		return n.StartsAt.Format(time.RFC3339Nano) + "/.."
+	}
+	return n.StartsAt.Format(time.RFC3339Nano) + "/" + calendarDiff(n.StartsAt.Time(), n.EndsAt.instant).String()
+}
+
+// Periods is a list of periods, such as the result of Cycles, with a stable JSON
+// form for snapshot tests: zeit.Periods(z.Cycles(12, zeit.Monthly)).
+type Periods []*Period
+
+// periodJSON is the wire form of one element of Periods. Field order is fixed.
+type periodJSON struct {
+	StartsAt *Zeit      `json:"startsAt"`
+	EndsAt   *Zeit      `json:"endsAt"`
+	Index    int        `json:"index"`
+	Kind     PeriodKind `json:"kind"`
+}
+
+// MarshalJSON implements json.Marshaler. Periods marshal in slice order as an array
+// of objects with the keys startsAt, endsAt, index and kind, in that order:
+//
+//	[{"startsAt":"2024-01-01T00:00:00Z","endsAt":"2024-02-01T00:00:00Z","index":0,"kind":"regular"}]
+//
+// The keys are camelCase for snapshot consumers, unlike a single Period, which
+// marshals with its Go field names as described by PeriodSchema.
+// Timestamps use ToUser, an open end is null and kind is always present.
+// A nil element marshals to null and a nil Periods to an empty array.
+func (ps Periods) MarshalJSON() ([]byte, error) {
+	out := make([]*periodJSON, len(ps))
+	for i, p := range ps {
+		if p == nil {
+			continue
+		}
+		out[i] = &periodJSON{StartsAt: p.StartsAt, EndsAt: p.EndsAt, Index: i, Kind: p.Kind}
+	}
+	return json.Marshal(out)
+}
+
+// Span returns a single period from the earliest start to the latest end of the
+// periods, for summarizing a customer's full billing history. The result is
+// open-ended if any period is. Gaps between periods are covered too.
+// Reversed periods are normalized first and nil elements are skipped.
+// Returns nil if there are no periods.
+func (ps Periods) Span() *Period {
+	var span *Period
+	for _, p := range ps {
+		if p == nil {
+			continue
+		}
+		n := p.Normalize()
+		if span == nil {
+			span = &Period{StartsAt: n.StartsAt, EndsAt: n.EndsAt}
+			continue
+		}
+		if n.StartsAt.Before(span.StartsAt) {
+			span.StartsAt = n.StartsAt
+		}
+		if span.EndsAt != nil && (n.EndsAt == nil || n.EndsAt.After(span.EndsAt)) {
+			span.EndsAt = n.EndsAt
+		}
+	}
+	return span
+}
+
+// ChainFault describes how a period breaks a chain of consecutive periods.
+type ChainFault int
+
+const (
+	// ChainInvalid marks a nil period or one that ends before it starts.
+	ChainInvalid ChainFault = iota
+	// ChainOutOfOrder marks a period that starts before the previous one.
+	ChainOutOfOrder
+	// ChainOverlap marks a period that starts before the previous one ends,
+	// including any period after an open-ended one.
+	ChainOverlap
+	// ChainGap marks a period that starts after the previous one ends.
+	ChainGap
+)
+
+// chainFaultNames holds the description of each ChainFault, indexed by value.
+var chainFaultNames = []string{"is invalid", "is out of order", "overlaps the previous period", "leaves a gap after the previous period"}
+
+// String describes the fault, e.g. "overlaps the previous period".
+func (f ChainFault) String() string {
+	if f < 0 || int(f) >= len(chainFaultNames) {
+		return fmt.Sprintf("ChainFault(%d)", int(f))
+	}
+	return chainFaultNames[f]
+}
+
+// ChainError reports the first period that breaks a chain. It wraps ErrBrokenChain.
+type ChainError struct {
+	Index int
+	Fault ChainFault
+}
+
+// Error implements error, e.g. "zeit: broken period chain: period 3 overlaps the previous period".
+func (e *ChainError) Error() string {
+	return fmt.Sprintf("%v: period %d %v", ErrBrokenChain, e.Index, e.Fault)
+}
+
+// Unwrap returns ErrBrokenChain.
+func (e *ChainError) Unwrap() error {
+	return ErrBrokenChain
+}
+
+// ValidateChain checks that periods form a single contiguous chain: each period is
+// valid and starts exactly where the previous one ends. Use it to sanity-check
+// imported billing histories. Only the last period may be open-ended.
+// Returns a *ChainError for the first offending period, or nil for a valid or empty chain.
+func ValidateChain(periods []*Period) error {
+	for i, p := range periods {
+		if p == nil || p.StartsAt == nil || !p.IsValid() {
+			return &ChainError{Index: i, Fault: ChainInvalid}
+		}
+		if i == 0 {
+			continue
+		}
+
+		prev := periods[i-1]
+		switch {
+		case p.StartsAt.Before(prev.StartsAt):
+			return &ChainError{Index: i, Fault: ChainOutOfOrder}
+		case prev.EndsAt == nil || p.StartsAt.Before(prev.EndsAt):
+			return &ChainError{Index: i, Fault: ChainOverlap}
+		case p.StartsAt.After(prev.EndsAt):
+			return &ChainError{Index: i, Fault: ChainGap}
+		}
+	}
+	return nil
+}
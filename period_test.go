@@ -0,0 +1,725 @@
+package zeit
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestPeriod_Equal(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	a := &Period{StartsAt: New(start, time.UTC), EndsAt: New(end, time.UTC)}
+	b := &Period{StartsAt: New(start, berlin), EndsAt: New(end, berlin)}
+	c := &Period{StartsAt: New(start, time.UTC), EndsAt: New(end.Add(time.Second), time.UTC)}
+
+	if !a.Equal(b) {
+		t.Error("Periods with the same instants should be equal regardless of timezone")
+	}
+	if a.Equal(c) {
+		t.Error("Periods with different ends should not be equal")
+	}
+}
+
+func TestPeriod_IsValid(t *testing.T) {
+	earlier := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	later := New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		period   *Period
+		name     string
+		expected bool
+	}{
+		{&Period{StartsAt: earlier, EndsAt: later}, "Ordered", true},
+		{&Period{StartsAt: later, EndsAt: earlier}, "Reversed", false},
+		{&Period{StartsAt: earlier, EndsAt: earlier}, "Empty", true},
+		{&Period{StartsAt: earlier}, "Open-ended", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.IsValid(); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestPeriod_Normalize(t *testing.T) {
+	earlier := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	later := New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	reversed := &Period{StartsAt: later, EndsAt: earlier}
+	normalized := reversed.Normalize()
+
+	if !normalized.StartsAt.Equal(earlier) || !normalized.EndsAt.Equal(later) {
+		t.Error("Normalize should swap a reversed period")
+	}
+	if normalized.Duration() <= 0 {
+		t.Errorf("Normalized duration should be positive, got %v", normalized.Duration())
+	}
+	if !reversed.StartsAt.Equal(later) {
+		t.Error("Normalize should not modify the original period")
+	}
+
+	ordered := &Period{StartsAt: earlier, EndsAt: later}
+	if !ordered.Normalize().Equal(ordered) {
+		t.Error("Normalize should keep an ordered period unchanged")
+	}
+}
+
+func TestPeriod_Equal_OpenEnded(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	end := New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	open := &Period{StartsAt: start}
+	closed := &Period{StartsAt: start, EndsAt: end}
+
+	if !open.Equal(&Period{StartsAt: start}) {
+		t.Error("Open-ended periods with the same start should be equal")
+	}
+	if open.Equal(closed) || closed.Equal(open) {
+		t.Error("Open-ended and closed periods should not be equal")
+	}
+}
+
+func TestPeriod_OpenEnded(t *testing.T) {
+	start := Now(time.UTC).Add(-48 * time.Hour)
+	p := &Period{StartsAt: start}
+
+	if !p.IsOpen() {
+		t.Error("Period without EndsAt should be open")
+	}
+	if p.IsEmpty() {
+		t.Error("Open-ended period should not be empty")
+	}
+	if !p.Contains(Now(time.UTC).AddDays(365)) {
+		t.Error("Open-ended period should contain future instants")
+	}
+	if p.Contains(start.Add(-time.Second)) {
+		t.Error("Open-ended period should not contain instants before its start")
+	}
+	if d := p.Duration(); d < 48*time.Hour || d > 49*time.Hour {
+		t.Errorf("Open-ended duration should measure elapsed time, got %v", d)
+	}
+	if !p.Normalize().IsOpen() {
+		t.Error("Normalize should keep an open-ended period open")
+	}
+}
+
+func TestPeriod_ZeroDuration(t *testing.T) {
+	at := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	p := &Period{StartsAt: at, EndsAt: at}
+
+	if !p.IsEmpty() {
+		t.Error("Period with equal start and end should be empty")
+	}
+	if p.Duration() != 0 {
+		t.Errorf("Expected zero duration, got %v", p.Duration())
+	}
+	if p.Contains(at) {
+		t.Error("Empty period should not contain its own start")
+	}
+}
+
+func TestPeriod_SnapToLocalDays(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	at := func(day, hour int) *Zeit { return New(time.Date(2024, 3, day, hour, 0, 0, 0, berlin), berlin) }
+
+	tests := []struct {
+		period        *Period
+		expectedStart time.Time
+		expectedEnd   time.Time
+		name          string
+	}{
+		{
+			name:          "Within a day",
+			period:        &Period{StartsAt: at(15, 10), EndsAt: at(15, 14)},
+			expectedStart: time.Date(2024, 3, 15, 0, 0, 0, 0, berlin),
+			expectedEnd:   time.Date(2024, 3, 16, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:          "End at midnight stays",
+			period:        &Period{StartsAt: at(15, 10), EndsAt: at(17, 0)},
+			expectedStart: time.Date(2024, 3, 15, 0, 0, 0, 0, berlin),
+			expectedEnd:   time.Date(2024, 3, 17, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:          "Across DST",
+			period:        &Period{StartsAt: at(30, 22), EndsAt: at(31, 5)},
+			expectedStart: time.Date(2024, 3, 30, 0, 0, 0, 0, berlin),
+			expectedEnd:   time.Date(2024, 4, 1, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:          "End in another zone",
+			period:        &Period{StartsAt: at(15, 10), EndsAt: New(time.Date(2024, 3, 16, 7, 0, 0, 0, tokyo), tokyo)},
+			expectedStart: time.Date(2024, 3, 15, 0, 0, 0, 0, berlin),
+			expectedEnd:   time.Date(2024, 3, 16, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:          "Reversed",
+			period:        &Period{StartsAt: at(16, 2), EndsAt: at(15, 23)},
+			expectedStart: time.Date(2024, 3, 15, 0, 0, 0, 0, berlin),
+			expectedEnd:   time.Date(2024, 3, 17, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:          "Open-ended",
+			period:        &Period{StartsAt: at(15, 10)},
+			expectedStart: time.Date(2024, 3, 15, 0, 0, 0, 0, berlin),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.period.SnapToLocalDays()
+			if !got.StartsAt.instant.Equal(tt.expectedStart) {
+				t.Errorf("Expected start %v, got %v", tt.expectedStart, got.StartsAt.Time())
+			}
+			if tt.expectedEnd.IsZero() {
+				if got.EndsAt != nil {
+					t.Errorf("Expected open end, got %v", got.EndsAt.Time())
+				}
+				return
+			}
+			if !got.EndsAt.instant.Equal(tt.expectedEnd) || got.EndsAt.Location() != berlin {
+				t.Errorf("Expected end %v, got %v", tt.expectedEnd, got.EndsAt.Time())
+			}
+		})
+	}
+
+	if (*Period)(nil).SnapToLocalDays() != nil {
+		t.Error("Expected nil for nil Period")
+	}
+}
+
+func TestPeriod_Overlap(t *testing.T) {
+	day := func(d int) *Zeit {
+		return New(time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC), time.UTC)
+	}
+
+	tests := []struct {
+		a         *Period
+		b         *Period
+		wantStart *Zeit
+		wantEnd   *Zeit
+		name      string
+		wantNil   bool
+	}{
+		{
+			name:      "Partial overlap",
+			a:         &Period{StartsAt: day(1), EndsAt: day(10)},
+			b:         &Period{StartsAt: day(5), EndsAt: day(15)},
+			wantStart: day(5),
+			wantEnd:   day(10),
+		},
+		{
+			name:      "Contained",
+			a:         &Period{StartsAt: day(1), EndsAt: day(20)},
+			b:         &Period{StartsAt: day(5), EndsAt: day(10)},
+			wantStart: day(5),
+			wantEnd:   day(10),
+		},
+		{
+			name:    "Touching",
+			a:       &Period{StartsAt: day(1), EndsAt: day(5)},
+			b:       &Period{StartsAt: day(5), EndsAt: day(10)},
+			wantNil: true,
+		},
+		{
+			name:    "Disjoint",
+			a:       &Period{StartsAt: day(1), EndsAt: day(5)},
+			b:       &Period{StartsAt: day(7), EndsAt: day(10)},
+			wantNil: true,
+		},
+		{
+			name:      "Open-ended with closed",
+			a:         &Period{StartsAt: day(5)},
+			b:         &Period{StartsAt: day(1), EndsAt: day(10)},
+			wantStart: day(5),
+			wantEnd:   day(10),
+		},
+		{
+			name:      "Both open-ended",
+			a:         &Period{StartsAt: day(5)},
+			b:         &Period{StartsAt: day(1)},
+			wantStart: day(5),
+		},
+		{
+			name:    "Open-ended after closed",
+			a:       &Period{StartsAt: day(10)},
+			b:       &Period{StartsAt: day(1), EndsAt: day(10)},
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.a.Overlap(tt.b)
+			if tt.wantNil {
+				if got != nil {
+					t.Errorf("Expected no overlap, got %v -> %v", got.StartsAt, got.EndsAt)
+				}
+				if tt.a.Overlaps(tt.b) {
+					t.Error("Overlaps should be false")
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("Expected an overlap, got nil")
+			}
+			want := &Period{StartsAt: tt.wantStart, EndsAt: tt.wantEnd}
+			if !got.Equal(want) {
+				t.Errorf("Expected %v -> %v, got %v -> %v", want.StartsAt, want.EndsAt, got.StartsAt, got.EndsAt)
+			}
+			if !tt.b.Overlaps(tt.a) {
+				t.Error("Overlaps should be symmetric")
+			}
+		})
+	}
+}
+
+func TestPeriod_FractionOf(t *testing.T) {
+	day := func(d int) *Zeit { return New(time.Date(2024, 4, d, 0, 0, 0, 0, time.UTC), time.UTC) }
+	april := &Period{StartsAt: day(1), EndsAt: New(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.UTC)}
+
+	tests := []struct {
+		period    *Period
+		container *Period
+		name      string
+		expected  float64
+	}{
+		{name: "A third", period: &Period{StartsAt: day(11), EndsAt: day(21)}, container: april, expected: 1.0 / 3},
+		{name: "Covers all", period: &Period{StartsAt: day(1).AddDays(-5), EndsAt: day(1).AddDays(40)}, container: april, expected: 1},
+		{name: "Partly outside", period: &Period{StartsAt: day(1).AddDays(-5), EndsAt: day(16)}, container: april, expected: 0.5},
+		{name: "Disjoint", period: &Period{StartsAt: day(1).AddDays(-5), EndsAt: day(1)}, container: april, expected: 0},
+		{name: "Open-ended period", period: &Period{StartsAt: day(16)}, container: april, expected: 0.5},
+		{name: "Reversed period", period: &Period{StartsAt: day(21), EndsAt: day(11)}, container: april, expected: 1.0 / 3},
+		{name: "Empty container", period: april, container: &Period{StartsAt: day(5), EndsAt: day(5)}, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.FractionOf(tt.container); math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		wantStart time.Time
+		wantEnd   time.Time
+		name      string
+		input     string
+		wantOpen  bool
+	}{
+		{
+			name:      "Start and end dates",
+			input:     "2024-01-01/2024-02-01",
+			wantStart: time.Date(2024, 1, 1, 0, 0, 0, 0, berlin),
+			wantEnd:   time.Date(2024, 2, 1, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:      "Start and end with offsets",
+			input:     "2024-01-01T00:00:00Z/2024-02-01T00:00:00Z",
+			wantStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "Start and duration clamps to the month end",
+			input:     "2024-01-31/P1M",
+			wantStart: time.Date(2024, 1, 31, 0, 0, 0, 0, berlin),
+			wantEnd:   time.Date(2024, 2, 29, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:      "Duration and end clamps to the month end",
+			input:     "P1M/2024-03-31",
+			wantStart: time.Date(2024, 2, 29, 0, 0, 0, 0, berlin),
+			wantEnd:   time.Date(2024, 3, 31, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:      "Duration across DST keeps local midnight",
+			input:     "2024-03-30/P2D",
+			wantStart: time.Date(2024, 3, 30, 0, 0, 0, 0, berlin),
+			wantEnd:   time.Date(2024, 4, 1, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:      "Duration and end",
+			input:     "PT12H/2024-01-02T00:00:00Z",
+			wantStart: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "Open-ended",
+			input:     "2024-01-01T10:30/..",
+			wantStart: time.Date(2024, 1, 1, 10, 30, 0, 0, berlin),
+			wantOpen:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParsePeriod(tt.input, berlin)
+			if err != nil {
+				t.Fatalf("ParsePeriod(%q) error: %v", tt.input, err)
+			}
+			if !p.StartsAt.Time().Equal(tt.wantStart) {
+				t.Errorf("Start: expected %v, got %v", tt.wantStart, p.StartsAt.Time())
+			}
+			if tt.wantOpen {
+				if !p.IsOpen() {
+					t.Error("Expected an open-ended period")
+				}
+				return
+			}
+			if !p.EndsAt.Time().Equal(tt.wantEnd) {
+				t.Errorf("End: expected %v, got %v", tt.wantEnd, p.EndsAt.Time())
+			}
+			if p.StartsAt.Location() != berlin || p.EndsAt.Location() != berlin {
+				t.Error("Parsed period should use the given location")
+			}
+		})
+	}
+}
+
+func TestParsePeriod_Invalid(t *testing.T) {
+	inputs := []string{
+		"2024-01-01",
+		"2024-01-01/2024-02-01/2024-03-01",
+		"P1D/P2D",
+		"2024-02-01/2024-01-01",
+		"not-a-date/2024-01-01",
+		"2024-01-01/P1X",
+		"../2024-01-01",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParsePeriod(input, time.UTC); err == nil {
+				t.Errorf("ParsePeriod(%q) should return error", input)
+			}
+		})
+	}
+}
+
+func TestParsePeriod_DerivedEndpointOutOfRange(t *testing.T) {
+	earliest, latest := ingestionRange()
+	withValidRange(t, earliest, latest)
+
+	inputs := []string{
+		"2400-01-01T00:00:00Z/P200Y",
+		"P200Y/2000-01-01T00:00:00Z",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParsePeriod(input, time.UTC); !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("Expected ErrOutOfRange, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPeriod_ISO8601(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	end := New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	p := &Period{StartsAt: start, EndsAt: end}
+
+	if got := p.ISO8601(); got != "2024-01-01T00:00:00Z/2024-02-01T00:00:00Z" {
+		t.Errorf("ISO8601: got %s", got)
+	}
+	if got := p.ISO8601Duration(); got != "2024-01-01T00:00:00Z/P1M" {
+		t.Errorf("ISO8601Duration: got %s", got)
+	}
+
+	open := &Period{StartsAt: start}
+	if got := open.ISO8601(); got != "2024-01-01T00:00:00Z/.." {
+		t.Errorf("Open-ended ISO8601: got %s", got)
+	}
+	if got := open.ISO8601Duration(); got != "2024-01-01T00:00:00Z/.." {
+		t.Errorf("Open-ended ISO8601Duration: got %s", got)
+	}
+}
+
+func TestPeriod_ISO8601_RoundTrip(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := New(time.Date(2024, 1, 31, 9, 0, 0, 0, berlin), berlin)
+
+	for _, p := range start.Cycles(14, Monthly) {
+		for _, s := range []string{p.ISO8601(), p.ISO8601Duration()} {
+			parsed, err := ParsePeriod(s, berlin)
+			if err != nil {
+				t.Fatalf("ParsePeriod(%q) error: %v", s, err)
+			}
+			if !parsed.Equal(p) {
+				t.Errorf("Round trip of %q produced %s", s, parsed.ISO8601())
+			}
+		}
+	}
+}
+
+func TestPeriod_ISO8601_SubSecond(t *testing.T) {
+	start := New(time.Date(2024, 1, 15, 10, 30, 0, 250000000, time.UTC), time.UTC)
+	end := New(time.Date(2024, 1, 15, 10, 30, 1, 500000000, time.UTC), time.UTC)
+	p := &Period{StartsAt: start, EndsAt: end}
+
+	if got := p.ISO8601(); got != "2024-01-15T10:30:00.25Z/2024-01-15T10:30:01.5Z" {
+		t.Errorf("ISO8601: got %s", got)
+	}
+	for _, s := range []string{p.ISO8601(), p.ISO8601Duration()} {
+		parsed, err := ParsePeriod(s, time.UTC)
+		if err != nil {
+			t.Fatalf("ParsePeriod(%q) error: %v", s, err)
+		}
+		if !parsed.Equal(p) {
+			t.Errorf("Round trip of %q produced %s", s, parsed.ISO8601())
+		}
+	}
+}
+
+func TestPeriod_Clone(t *testing.T) {
+	start := New(time.Date(2024, 1, 17, 10, 0, 0, 0, time.UTC), time.UTC)
+	p := start.CyclesAnchoredWeekly(1, time.Monday)[0]
+	p.Kind = PeriodTrial
+
+	clone := p.Clone()
+	if clone == p || clone.StartsAt == p.StartsAt || clone.EndsAt == p.EndsAt {
+		t.Error("Clone should not share pointers with the original")
+	}
+	if !clone.Equal(p) || clone.Kind != PeriodTrial || !clone.IsPartial() {
+		t.Error("Clone should keep instants, kind and partial flag")
+	}
+
+	_ = clone.StartsAt.UnmarshalJSON([]byte(`"2030-01-01T00:00:00Z"`))
+	if !p.StartsAt.Equal(start) {
+		t.Error("Editing the clone should not affect the original")
+	}
+
+	open := &Period{StartsAt: start}
+	if open.Clone().EndsAt != nil {
+		t.Error("Clone should keep an open end")
+	}
+
+	var nilPeriod *Period
+	if nilPeriod.Clone() != nil {
+		t.Error("Clone of nil should be nil")
+	}
+}
+
+func TestPeriods_MarshalJSON(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	cycles := start.Cycles(2, Monthly)
+	cycles[1].Kind = PeriodTrial
+
+	tests := []struct {
+		name     string
+		expected string
+		periods  Periods
+	}{
+		{
+			name:    "Cycles",
+			periods: Periods(cycles),
+			expected: `[{"startsAt":"2024-01-01T00:00:00Z","endsAt":"2024-02-01T00:00:00Z","index":0,"kind":"regular"},` +
+				`{"startsAt":"2024-02-01T00:00:00Z","endsAt":"2024-03-01T00:00:00Z","index":1,"kind":"trial"}]`,
+		},
+		{
+			name:     "Open-ended",
+			periods:  Periods{{StartsAt: start}},
+			expected: `[{"startsAt":"2024-01-01T00:00:00Z","endsAt":null,"index":0,"kind":"regular"}]`,
+		},
+		{
+			name:     "Nil element",
+			periods:  Periods{nil},
+			expected: `[null]`,
+		},
+		{
+			name:     "Nil",
+			expected: `[]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.periods)
+			if err != nil {
+				t.Fatalf("Marshal error: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, data)
+			}
+		})
+	}
+}
+
+func TestPeriods_Span(t *testing.T) {
+	at := func(month, day int) *Zeit {
+		return New(time.Date(2024, time.Month(month), day, 0, 0, 0, 0, time.UTC), time.UTC)
+	}
+
+	tests := []struct {
+		start   *Zeit
+		end     *Zeit
+		name    string
+		periods Periods
+	}{
+		{
+			name:    "Cycles",
+			periods: Periods(at(1, 1).Cycles(3, Monthly)),
+			start:   at(1, 1),
+			end:     at(4, 1),
+		},
+		{
+			name:    "Unordered with gap",
+			periods: Periods{{StartsAt: at(5, 1), EndsAt: at(6, 1)}, {StartsAt: at(2, 1), EndsAt: at(3, 1)}},
+			start:   at(2, 1),
+			end:     at(6, 1),
+		},
+		{
+			name:    "Nested",
+			periods: Periods{{StartsAt: at(1, 1), EndsAt: at(12, 1)}, {StartsAt: at(3, 1), EndsAt: at(4, 1)}},
+			start:   at(1, 1),
+			end:     at(12, 1),
+		},
+		{
+			name:    "Reversed",
+			periods: Periods{{StartsAt: at(3, 1), EndsAt: at(2, 1)}},
+			start:   at(2, 1),
+			end:     at(3, 1),
+		},
+		{
+			name:    "Open-ended",
+			periods: Periods{{StartsAt: at(2, 1)}, {StartsAt: at(1, 1), EndsAt: at(9, 1)}},
+			start:   at(1, 1),
+		},
+		{
+			name:    "Nil element",
+			periods: Periods{nil, {StartsAt: at(1, 1), EndsAt: at(2, 1)}},
+			start:   at(1, 1),
+			end:     at(2, 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			span := tt.periods.Span()
+			if span == nil {
+				t.Fatal("Expected a span, got nil")
+			}
+			if !span.StartsAt.Equal(tt.start) {
+				t.Errorf("Expected start %v, got %v", tt.start, span.StartsAt)
+			}
+			if tt.end == nil {
+				if !span.IsOpen() {
+					t.Errorf("Expected open end, got %v", span.EndsAt)
+				}
+			} else if span.EndsAt == nil || !span.EndsAt.Equal(tt.end) {
+				t.Errorf("Expected end %v, got %v", tt.end, span.EndsAt)
+			}
+		})
+	}
+}
+
+func TestPeriods_Span_Empty(t *testing.T) {
+	for _, ps := range []Periods{nil, {}, {nil}} {
+		if span := ps.Span(); span != nil {
+			t.Errorf("Expected nil, got %v", span)
+		}
+	}
+}
+
+func TestValidateChain(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	chain := start.Cycles(3, Monthly)
+	day := func(month time.Month, d int) *Zeit {
+		return New(time.Date(2024, month, d, 0, 0, 0, 0, time.UTC), time.UTC)
+	}
+
+	tests := []struct {
+		name    string
+		periods []*Period
+		index   int
+		fault   ChainFault
+		valid   bool
+	}{
+		{name: "Cycles", periods: chain, valid: true},
+		{name: "Open-ended last", periods: append(start.Cycles(2, Monthly), &Period{StartsAt: day(3, 1)}), valid: true},
+		{name: "Empty", valid: true},
+		{
+			name:    "Gap",
+			periods: []*Period{chain[0], {StartsAt: day(2, 5), EndsAt: day(3, 1)}},
+			index:   1,
+			fault:   ChainGap,
+		},
+		{
+			name:    "Overlap",
+			periods: []*Period{chain[0], chain[1], {StartsAt: day(2, 20), EndsAt: day(4, 1)}},
+			index:   2,
+			fault:   ChainOverlap,
+		},
+		{
+			name:    "Out of order",
+			periods: []*Period{chain[1], chain[0]},
+			index:   1,
+			fault:   ChainOutOfOrder,
+		},
+		{
+			name:    "After open-ended",
+			periods: []*Period{{StartsAt: day(1, 1)}, chain[1]},
+			index:   1,
+			fault:   ChainOverlap,
+		},
+		{
+			name:    "Reversed period",
+			periods: []*Period{chain[0], {StartsAt: day(3, 1), EndsAt: day(2, 1)}},
+			index:   1,
+			fault:   ChainInvalid,
+		},
+		{
+			name:    "Nil period",
+			periods: []*Period{chain[0], nil},
+			index:   1,
+			fault:   ChainInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateChain(tt.periods)
+
+			if tt.valid {
+				if err != nil {
+					t.Errorf("Expected valid chain, got %v", err)
+				}
+				return
+			}
+
+			var chainErr *ChainError
+			if !errors.As(err, &chainErr) {
+				t.Fatalf("Expected *ChainError, got %v", err)
+			}
+			if chainErr.Index != tt.index || chainErr.Fault != tt.fault {
+				t.Errorf("Expected index %d %v, got index %d %v", tt.index, tt.fault, chainErr.Index, chainErr.Fault)
+			}
+			if !errors.Is(err, ErrBrokenChain) {
+				t.Error("Expected error to wrap ErrBrokenChain")
+			}
+		})
+	}
+}
+
+func TestChainError_Error(t *testing.T) {
+	err := &ChainError{Index: 3, Fault: ChainOverlap}
+
+	expected := "zeit: broken period chain: period 3 overlaps the previous period"
+	if err.Error() != expected {
+		t.Errorf("Expected %q, got %q", expected, err.Error())
+	}
+}
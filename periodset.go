@@ -0,0 +1,69 @@
+package zeit
+
+import (
+	"slices"
+	"time"
+)
+
+// PeriodSet is an unordered collection of periods that may overlap, such as the
+// subscription periods of a customer across upgrades and reactivations.
+type PeriodSet []*Period
+
+// Coalesce merges overlapping and touching periods into maximal spans, sorted by
+// start. Reversed periods are normalized first; nil and empty periods are dropped.
+// An open-ended period absorbs every period starting after it. The merged periods
+// are regular and not partial, as a span may combine several kinds.
+func (s PeriodSet) Coalesce() PeriodSet {
+	sorted := make([]*Period, 0, len(s))
+	for _, p := range s {
+		if p != nil && !p.IsEmpty() {
+			sorted = append(sorted, p.Normalize())
+		}
+	}
+	slices.SortFunc(sorted, func(a, b *Period) int {
+		return a.StartsAt.instant.Compare(b.StartsAt.instant)
+	})
+
+	merged := PeriodSet{}
+	for _, p := range sorted {
+		if n := len(merged); n > 0 {
+			last := merged[n-1]
+			if last.EndsAt == nil || !p.StartsAt.After(last.EndsAt) {
+				if p.EndsAt == nil || last.EndsAt != nil && p.EndsAt.After(last.EndsAt) {
+					last.EndsAt = p.EndsAt
+				}
+				continue
+			}
+		}
+		merged = append(merged, &Period{StartsAt: p.StartsAt, EndsAt: p.EndsAt})
+	}
+
+	return merged
+}
+
+// TotalDuration returns the time covered by at least one period of the set.
+// Overlaps are counted once, so upgrades that overlap the old plan don't
+// inflate entitlements. Open-ended periods count until now, like Period.Duration.
+func (s PeriodSet) TotalDuration() time.Duration {
+	var total time.Duration
+	for _, p := range s.Coalesce() {
+		total += p.Duration()
+	}
+	return total
+}
+
+// TotalBusinessDays returns the number of business days of cal covered by at least
+// one period of the set, each day counted once. Days are counted per coalesced
+// period with the [start, end) semantics of Duration.BusinessDaysIn, and open-ended
+// periods until now. A nil cal counts Monday to Friday.
+func (s PeriodSet) TotalBusinessDays(cal *Calendar) int {
+	total := 0
+	for _, p := range s.Coalesce() {
+		end := p.EndsAt
+		if end == nil {
+			end = New(now(), p.StartsAt.location)
+		}
+		total += p.StartsAt.Until(end).BusinessDaysIn(cal)
+	}
+	return total
+}
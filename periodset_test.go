@@ -0,0 +1,191 @@
+package zeit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeriodSet_Coalesce(t *testing.T) {
+	day := func(month time.Month, d int) *Zeit {
+		return New(time.Date(2024, month, d, 0, 0, 0, 0, time.UTC), time.UTC)
+	}
+	span := func(from, to *Zeit) *Period {
+		return &Period{StartsAt: from, EndsAt: to}
+	}
+
+	tests := []struct {
+		name     string
+		set      PeriodSet
+		expected PeriodSet
+	}{
+		{
+			name:     "Touching periods merge",
+			set:      PeriodSet{span(day(1, 1), day(2, 1)), span(day(2, 1), day(3, 1))},
+			expected: PeriodSet{span(day(1, 1), day(3, 1))},
+		},
+		{
+			name:     "Overlapping periods merge",
+			set:      PeriodSet{span(day(1, 1), day(1, 20)), span(day(1, 10), day(2, 1))},
+			expected: PeriodSet{span(day(1, 1), day(2, 1))},
+		},
+		{
+			name:     "Contained period",
+			set:      PeriodSet{span(day(1, 1), day(3, 1)), span(day(1, 10), day(1, 20))},
+			expected: PeriodSet{span(day(1, 1), day(3, 1))},
+		},
+		{
+			name: "Gap keeps periods apart, unsorted input",
+			set:  PeriodSet{span(day(4, 1), day(5, 1)), span(day(1, 1), day(2, 1)), span(day(1, 15), day(2, 10))},
+			expected: PeriodSet{
+				span(day(1, 1), day(2, 10)),
+				span(day(4, 1), day(5, 1)),
+			},
+		},
+		{
+			name:     "Open-ended absorbs later periods",
+			set:      PeriodSet{{StartsAt: day(2, 1)}, span(day(1, 1), day(2, 15)), span(day(6, 1), day(7, 1))},
+			expected: PeriodSet{{StartsAt: day(1, 1)}},
+		},
+		{
+			name:     "Reversed, empty and nil periods",
+			set:      PeriodSet{span(day(2, 1), day(1, 1)), span(day(5, 1), day(5, 1)), nil},
+			expected: PeriodSet{span(day(1, 1), day(2, 1))},
+		},
+		{
+			name:     "Empty set",
+			expected: PeriodSet{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.set.Coalesce()
+
+			if len(got) != len(tt.expected) {
+				t.Fatalf("Expected %d periods, got %d", len(tt.expected), len(got))
+			}
+			for i := range got {
+				if !got[i].Equal(tt.expected[i]) {
+					t.Errorf("Period %d: expected %s, got %s", i, tt.expected[i].ISO8601(), got[i].ISO8601())
+				}
+			}
+		})
+	}
+}
+
+func TestPeriodSet_Coalesce_KeepsInput(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	cycles := start.Cycles(3, Monthly)
+	cycles[0].Kind = PeriodTrial
+	firstEnd := cycles[0].EndsAt
+
+	merged := PeriodSet(cycles).Coalesce()
+
+	if len(merged) != 1 || merged[0].Kind != PeriodRegular {
+		t.Errorf("Expected one regular period, got %d", len(merged))
+	}
+	if cycles[0].EndsAt != firstEnd || cycles[0].Kind != PeriodTrial {
+		t.Error("Coalesce should not modify the input periods")
+	}
+}
+
+func TestPeriodSet_TotalDuration(t *testing.T) {
+	day := func(month time.Month, d int) *Zeit {
+		return New(time.Date(2024, month, d, 0, 0, 0, 0, time.UTC), time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		set      PeriodSet
+		expected time.Duration
+	}{
+		{
+			name: "Overlap counted once",
+			set: PeriodSet{
+				{StartsAt: day(1, 1), EndsAt: day(1, 11)},
+				{StartsAt: day(1, 6), EndsAt: day(1, 16)},
+			},
+			expected: 15 * 24 * time.Hour,
+		},
+		{
+			name: "Disjoint periods add up",
+			set: PeriodSet{
+				{StartsAt: day(1, 1), EndsAt: day(1, 3)},
+				{StartsAt: day(2, 1), EndsAt: day(2, 2)},
+			},
+			expected: 3 * 24 * time.Hour,
+		},
+		{
+			name:     "Empty set",
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.TotalDuration(); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestPeriodSet_TotalBusinessDays(t *testing.T) {
+	newYork, _ := settlementCalendars()
+	day := func(month time.Month, d int) *Zeit {
+		return New(time.Date(2024, month, d, 0, 0, 0, 0, time.UTC), time.UTC)
+	}
+
+	tests := []struct {
+		cal      *Calendar
+		name     string
+		set      PeriodSet
+		expected int
+	}{
+		{
+			name: "Overlapping weeks counted once",
+			set: PeriodSet{
+				{StartsAt: day(7, 1), EndsAt: day(7, 8)},
+				{StartsAt: day(7, 3), EndsAt: day(7, 10)},
+			},
+			expected: 7,
+		},
+		{
+			name: "Holiday excluded",
+			set: PeriodSet{
+				{StartsAt: day(7, 1), EndsAt: day(7, 8)},
+				{StartsAt: day(7, 3), EndsAt: day(7, 10)},
+			},
+			cal:      newYork,
+			expected: 6,
+		},
+		{
+			name: "Gap within a day",
+			set: PeriodSet{
+				{StartsAt: New(time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC), time.UTC), EndsAt: day(7, 3)},
+				{StartsAt: New(time.Date(2024, 7, 3, 12, 0, 0, 0, time.UTC), time.UTC), EndsAt: day(7, 5)},
+			},
+			expected: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.TotalBusinessDays(tt.cal); got != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestPeriodSet_Totals_OpenEnded(t *testing.T) {
+	start := Now(time.UTC).AddDays(-14)
+	set := PeriodSet{{StartsAt: start}, {StartsAt: start, EndsAt: start.AddDays(3)}}
+
+	if d := set.TotalDuration(); d < 14*24*time.Hour || d > 14*24*time.Hour+time.Minute {
+		t.Errorf("Expected about 14 days, got %v", d)
+	}
+	if days := set.TotalBusinessDays(nil); days != 10 {
+		t.Errorf("Expected 10 business days in two weeks, got %d", days)
+	}
+}
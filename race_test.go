@@ -0,0 +1,106 @@
+package zeit
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+)
+
+// These tests share values across goroutines. Run them with -race to detect
+// unsynchronized state introduced into Zeit, Period, Calendar or package settings.
+
+const raceWorkers = 8
+
+func runConcurrently(t *testing.T, fn func(worker int)) {
+	t.Helper()
+
+	var wg sync.WaitGroup
+	for worker := range raceWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				fn(worker)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRace_SharedZeit(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	shared := New(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), berlin)
+	want := shared.ToUser()
+	formatter := NewFormatter(time.RFC1123, tokyo)
+
+	runConcurrently(t, func(worker int) {
+		_ = shared.Format(time.RFC1123)
+		_ = formatter.Format(shared)
+		_ = shared.FormatLocalized("Monday, January 2nd", German)
+		_ = shared.In(tokyo).ToUser()
+		_ = shared.Add(time.Duration(worker) * time.Hour)
+		_ = shared.AddSpan(Span(worker, Months))
+		_ = shared.StartOfHour()
+		_, _ = shared.WeekOfYear(ISOWeek)
+		_, _ = json.Marshal(shared)
+		_, _ = shared.Value()
+
+		if got := shared.ToUser(); got != want {
+			t.Errorf("Shared Zeit changed: expected %s, got %s", want, got)
+		}
+	})
+}
+
+func TestRace_SharedPeriodsAndCalendar(t *testing.T) {
+	start := New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+	periods := start.Cycles(12, Monthly)
+	cal := NewCalendar(Holiday{Name: "New Year", Month: time.January, Day: 1}).
+		WithAbsences(periods[3])
+	contract := NewContract(start, nil, Monthly, nil)
+
+	runConcurrently(t, func(worker int) {
+		p := periods[worker%len(periods)]
+		_ = p.Contains(start.AddDays(worker))
+		_ = p.Overlap(periods[(worker+1)%len(periods)])
+		_ = p.ISO8601()
+		_ = p.Clone()
+		_, _ = cal.AddBusinessDays(start, worker)
+		_ = cal.HolidayPeriods(2024, time.UTC)
+		_ = contract.CurrentPeriod(start.AddDays(worker * 10))
+		_ = start.Until(p.EndsAt).DayBreakdown(cal)
+	})
+}
+
+func TestRace_ValidRange(t *testing.T) {
+	t.Cleanup(func() { _ = SetValidRange(nil, nil) })
+	earliest, latest := ingestionRange()
+	z := New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	runConcurrently(t, func(worker int) {
+		if worker == 0 {
+			_ = SetValidRange(earliest, latest)
+			_ = SetValidRange(nil, nil)
+			return
+		}
+		if err := z.Validate(); err != nil {
+			t.Errorf("Validate error: %v", err)
+		}
+		_ = z.Clamp()
+		_, _ = ValidRange()
+	})
+}
+
+func TestRace_OffsetProvider(t *testing.T) {
+	t.Cleanup(func() { SetOffsetProvider(nil) })
+
+	runConcurrently(t, func(worker int) {
+		if worker == 0 {
+			SetOffsetProvider(func() time.Duration { return time.Second })
+			SetOffsetProvider(nil)
+			return
+		}
+		_ = Now(time.UTC)
+	})
+}
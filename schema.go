@@ -0,0 +1,43 @@
+package zeit
+
+// ZeitSchema returns the JSON Schema fragment describing a Zeit as it marshals
+// to JSON: an RFC3339 string. Suitable for OpenAPI 3.1 (JSON Schema 2020-12)
+// component definitions. Each call returns a fresh map the caller may modify.
+func ZeitSchema() map[string]any {
+	return map[string]any{
+		"type":    "string",
+		"format":  "date-time",
+		"example": "2024-01-15T10:30:00+01:00",
+	}
+}
+
+// PeriodSchema returns the JSON Schema fragment describing a Period as it marshals
+// to JSON: an object with RFC3339 StartsAt and EndsAt, where EndsAt is null for
+// open-ended periods, and an optional Kind that is omitted for regular periods.
+// Each call returns a fresh map the caller may modify.
+func PeriodSchema() map[string]any {
+	endsAt := ZeitSchema()
+	endsAt["type"] = []any{"string", "null"}
+	endsAt["description"] = "Exclusive end; null for open-ended periods"
+
+	startsAt := ZeitSchema()
+	startsAt["description"] = "Inclusive start"
+
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"StartsAt": startsAt,
+			"EndsAt":   endsAt,
+			"Kind": map[string]any{
+				"type":        "string",
+				"enum":        []any{"regular", "trial", "grace", "dunning"},
+				"description": "Billing kind; omitted for regular periods",
+			},
+		},
+		"required": []any{"StartsAt", "EndsAt"},
+		"example": map[string]any{
+			"StartsAt": "2024-01-01T00:00:00+01:00",
+			"EndsAt":   "2024-02-01T00:00:00+01:00",
+		},
+	}
+}
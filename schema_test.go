@@ -0,0 +1,62 @@
+package zeit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestZeitSchema(t *testing.T) {
+	schema := ZeitSchema()
+
+	if schema["type"] != "string" || schema["format"] != "date-time" {
+		t.Errorf("Unexpected schema: %v", schema)
+	}
+
+	// The example must be something the Zeit actually accepts
+	example, _ := schema["example"].(string)
+	if _, err := FromUser(example, time.UTC); err != nil {
+		t.Errorf("Example %q does not parse: %v", example, err)
+	}
+
+	schema["type"] = "changed"
+	if ZeitSchema()["type"] != "string" {
+		t.Error("ZeitSchema should return a fresh map on each call")
+	}
+}
+
+func TestPeriodSchema_MatchesMarshaledShape(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	end := New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	properties, _ := PeriodSchema()["properties"].(map[string]any)
+	required, _ := PeriodSchema()["required"].([]any)
+
+	for _, p := range []*Period{{StartsAt: start, EndsAt: end}, {StartsAt: start}, {StartsAt: start, EndsAt: end, Kind: PeriodTrial}} {
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+
+		var decoded map[string]any
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal error: %v", err)
+		}
+		for _, key := range required {
+			if _, ok := decoded[key.(string)]; !ok {
+				t.Errorf("Marshaled %s is missing required field %q", data, key)
+			}
+		}
+		for key := range decoded {
+			if _, ok := properties[key]; !ok {
+				t.Errorf("Marshaled field %q missing from schema", key)
+			}
+		}
+	}
+
+	var example Period
+	data, _ := json.Marshal(PeriodSchema()["example"])
+	if err := json.Unmarshal(data, &example); err != nil || !example.IsValid() {
+		t.Errorf("Example %s does not decode to a valid Period: %v", data, err)
+	}
+}
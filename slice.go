@@ -0,0 +1,90 @@
+package zeit
+
+import (
+	"sort"
+	"time"
+)
+
+// FromDatabaseSlice converts Unix timestamps to Zeit values in loc, like calling
+// FromDatabase for each. All values share a single allocation, which keeps
+// loading large event tables cheap. A nil loc defaults to UTC.
+func FromDatabaseSlice(timestamps []int64, loc *time.Location) []*Zeit {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	block := make([]Zeit, len(timestamps))
+	zs := make([]*Zeit, len(timestamps))
+	for i, ts := range timestamps {
+		block[i] = Zeit{instant: time.Unix(ts, 0).UTC(), location: loc}
+		zs[i] = &block[i]
+	}
+
+	return zs
+}
+
+// ToDatabaseSlice converts Zeit values to Unix timestamps, like calling ToDatabase for each.
+func ToDatabaseSlice(zs []*Zeit) []int64 {
+	timestamps := make([]int64, len(zs))
+	for i, z := range zs {
+		timestamps[i] = z.ToDatabase()
+	}
+	return timestamps
+}
+
+// SearchSorted returns the index of the first value in times that is not before
+// target, or len(times) if there is none. times must be sorted in ascending order.
+// Like sort.Search, the result is also where target would be inserted to keep the
+// slice sorted; times[i].Equal(target) tells whether it is present.
+func SearchSorted(times []*Zeit, target *Zeit) int {
+	return sort.Search(len(times), func(i int) bool {
+		return !times[i].Before(target)
+	})
+}
+
+// PeriodsCovering returns the periods that contain z, in their original order.
+// sortedPeriods must be sorted by StartsAt, and by EndsAt where they overlap, as
+// produced by Cycles, CyclesUntil or SlidingWindows; only the last may be open-ended.
+// Runs in logarithmic time plus the number of matches.
+// Returns an empty slice if no period contains z.
+func PeriodsCovering(sortedPeriods []*Period, z *Zeit) []*Period {
+	// First period starting after z; only periods before it can contain z.
+	n := sort.Search(len(sortedPeriods), func(i int) bool {
+		return sortedPeriods[i].StartsAt.After(z)
+	})
+
+	// Ends are sorted too, so the matches are a run ending at n-1.
+	first := n
+	for first > 0 && sortedPeriods[first-1].Contains(z) {
+		first--
+	}
+
+	return append([]*Period{}, sortedPeriods[first:n]...)
+}
+
+// IsMonotonic reports whether times never go backwards: each value is equal to or
+// after the one before it. Out-of-order values point to clock skew or reordered
+// events. Empty and single-value slices are monotonic.
+func IsMonotonic(times []*Zeit) bool {
+	for i := 1; i < len(times); i++ {
+		if times[i].Before(times[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EnforceMonotonic returns a copy of times in which every value that goes backwards
+// is raised to the latest value before it, keeping its own timezone. Values already
+// in order are kept as is, so an entry that differs from the input by pointer was
+// adjusted. The input slice is not modified.
+func EnforceMonotonic(times []*Zeit) []*Zeit {
+	result := make([]*Zeit, len(times))
+	for i, z := range times {
+		if i > 0 && z.Before(result[i-1]) {
+			z = New(result[i-1].instant, z.location)
+		}
+		result[i] = z
+	}
+	return result
+}
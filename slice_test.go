@@ -0,0 +1,262 @@
+package zeit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFromDatabaseSlice(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		loc        *time.Location
+		expected   *time.Location
+		name       string
+		timestamps []int64
+	}{
+		{
+			name:       "With location",
+			timestamps: []int64{0, 1705312800, -86400},
+			loc:        berlin,
+			expected:   berlin,
+		},
+		{
+			name:       "Nil location defaults to UTC",
+			timestamps: []int64{1705312800},
+			expected:   time.UTC,
+		},
+		{
+			name:     "Empty",
+			expected: time.UTC,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zs := FromDatabaseSlice(tt.timestamps, tt.loc)
+
+			if len(zs) != len(tt.timestamps) {
+				t.Fatalf("Expected %d values, got %d", len(tt.timestamps), len(zs))
+			}
+			for i, z := range zs {
+				want := FromDatabase(tt.timestamps[i], tt.loc)
+				if !z.instant.Equal(want.instant) || z.instant.Location() != time.UTC {
+					t.Errorf("Expected %v, got %v", want.instant, z.instant)
+				}
+				if z.location != tt.expected {
+					t.Errorf("Expected location %v, got %v", tt.expected, z.location)
+				}
+			}
+		})
+	}
+}
+
+func TestToDatabaseSlice(t *testing.T) {
+	timestamps := []int64{1705312800, 0, -86400}
+
+	got := ToDatabaseSlice(FromDatabaseSlice(timestamps, time.UTC))
+
+	if len(got) != len(timestamps) {
+		t.Fatalf("Expected %d timestamps, got %d", len(timestamps), len(got))
+	}
+	for i := range timestamps {
+		if got[i] != timestamps[i] {
+			t.Errorf("Expected %d, got %d", timestamps[i], got[i])
+		}
+	}
+}
+
+func BenchmarkFromDatabaseSlice(b *testing.B) {
+	timestamps := make([]int64, 10000)
+	for i := range timestamps {
+		timestamps[i] = 1705312800 + int64(i)*60
+	}
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_ = FromDatabaseSlice(timestamps, time.UTC)
+	}
+}
+
+func TestSearchSorted(t *testing.T) {
+	times := FromDatabaseSlice([]int64{100, 200, 200, 300}, time.UTC)
+
+	tests := []struct {
+		name     string
+		target   int64
+		expected int
+	}{
+		{name: "Before all", target: 50, expected: 0},
+		{name: "Exact match", target: 100, expected: 0},
+		{name: "Between", target: 150, expected: 1},
+		{name: "First of duplicates", target: 200, expected: 1},
+		{name: "Last", target: 300, expected: 3},
+		{name: "After all", target: 400, expected: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SearchSorted(times, FromDatabase(tt.target, time.UTC))
+			if got != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+
+	if SearchSorted(nil, FromDatabase(0, time.UTC)) != 0 {
+		t.Error("Empty slice should return 0")
+	}
+}
+
+func TestPeriodsCovering(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	monthly := start.Cycles(12, Monthly)
+	rolling := start.SlidingWindows(30*24*time.Hour, 24*time.Hour, 60)
+	openEnded := append(start.Cycles(2, Monthly), &Period{StartsAt: monthly[2].StartsAt})
+
+	tests := []struct {
+		at       time.Time
+		name     string
+		periods  []*Period
+		expected int
+	}{
+		{
+			name:     "Cycle containing z",
+			periods:  monthly,
+			at:       time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+			expected: 1,
+		},
+		{
+			name:     "Boundary belongs to later period",
+			periods:  monthly,
+			at:       time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			expected: 1,
+		},
+		{
+			name:     "Before first period",
+			periods:  monthly,
+			at:       time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+			expected: 0,
+		},
+		{
+			name:     "After last period",
+			periods:  monthly,
+			at:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: 0,
+		},
+		{
+			name:     "Overlapping windows",
+			periods:  rolling,
+			at:       time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC),
+			expected: 10,
+		},
+		{
+			name:     "Open-ended last period",
+			periods:  openEnded,
+			at:       time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := New(tt.at, time.UTC)
+			got := PeriodsCovering(tt.periods, z)
+
+			if len(got) != tt.expected {
+				t.Fatalf("Expected %d periods, got %d", tt.expected, len(got))
+			}
+			for i, p := range got {
+				if !p.Contains(z) {
+					t.Errorf("Period %d does not contain z", i)
+				}
+				if i > 0 && p.StartsAt.Before(got[i-1].StartsAt) {
+					t.Error("Periods should keep their original order")
+				}
+			}
+		})
+	}
+
+	if got := PeriodsCovering(nil, start); got == nil || len(got) != 0 {
+		t.Error("Expected empty, non-nil slice")
+	}
+}
+
+func TestIsMonotonic(t *testing.T) {
+	tests := []struct {
+		name       string
+		timestamps []int64
+		expected   bool
+	}{
+		{name: "Empty", expected: true},
+		{name: "Single", timestamps: []int64{100}, expected: true},
+		{name: "Increasing", timestamps: []int64{100, 200, 300}, expected: true},
+		{name: "Equal values", timestamps: []int64{100, 100, 200}, expected: true},
+		{name: "Out of order", timestamps: []int64{100, 300, 200}, expected: false},
+		{name: "Decreasing", timestamps: []int64{300, 200, 100}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IsMonotonic(FromDatabaseSlice(tt.timestamps, time.UTC))
+			if got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestEnforceMonotonic(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		name       string
+		timestamps []int64
+		expected   []int64
+		adjusted   []bool
+	}{
+		{
+			name:       "Already monotonic",
+			timestamps: []int64{100, 200, 300},
+			expected:   []int64{100, 200, 300},
+			adjusted:   []bool{false, false, false},
+		},
+		{
+			name:       "Single skewed value",
+			timestamps: []int64{100, 300, 200, 400},
+			expected:   []int64{100, 300, 300, 400},
+			adjusted:   []bool{false, false, true, false},
+		},
+		{
+			name:       "Run behind a jump",
+			timestamps: []int64{500, 100, 200, 600},
+			expected:   []int64{500, 500, 500, 600},
+			adjusted:   []bool{false, true, true, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			times := FromDatabaseSlice(tt.timestamps, berlin)
+			got := EnforceMonotonic(times)
+
+			if !IsMonotonic(got) {
+				t.Error("Result should be monotonic")
+			}
+			for i, z := range got {
+				if z.ToDatabase() != tt.expected[i] {
+					t.Errorf("Index %d: expected %d, got %d", i, tt.expected[i], z.ToDatabase())
+				}
+				if (z != times[i]) != tt.adjusted[i] {
+					t.Errorf("Index %d: expected adjusted %v", i, tt.adjusted[i])
+				}
+				if z.location != berlin {
+					t.Errorf("Index %d: expected location %v, got %v", i, berlin, z.location)
+				}
+				if times[i].ToDatabase() != tt.timestamps[i] {
+					t.Error("Input should not be modified")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package zeit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SpanUnit is the unit of a CalendarSpan.
+type SpanUnit int
+
+const (
+	// Seconds are absolute seconds.
+	Seconds SpanUnit = iota
+	// Minutes are absolute minutes.
+	Minutes
+	// Hours are absolute hours.
+	Hours
+	// Days are calendar days that keep the wall-clock time.
+	Days
+	// Weeks are seven calendar days.
+	Weeks
+	// Months are calendar months, clamped to the last day of shorter months.
+	Months
+	// Years are calendar years, moving Feb 29 to Feb 28 in non-leap years.
+	Years
+)
+
+// CalendarSpan is an abstract length of time such as "3 days" or "1 month".
+// Unlike Duration, which measures the distance between two Zeit instances,
+// a span is not tied to any instant and is applied with calendar semantics.
+// Create via Span().
+type CalendarSpan struct {
+	Count int
+	Unit  SpanUnit
+}
+
+// Span creates a CalendarSpan of count units. Negative counts go backwards.
+func Span(count int, unit SpanUnit) CalendarSpan {
+	return CalendarSpan{Count: count, Unit: unit}
+}
+
+// AddSpan returns a new Zeit with the span added.
+// Seconds, minutes and hours are absolute. Days and weeks move the calendar date
+// in the Zeit's timezone and keep the wall-clock time across DST changes.
+// Months and years clamp to the last day of the target month (Jan 31 + 1 month = Feb 29).
+// Calendar units behave like AddDate, including around DST changes.
+func (z *Zeit) AddSpan(s CalendarSpan) *Zeit {
+	switch s.Unit {
+	case Seconds:
+		return z.Add(time.Duration(s.Count) * time.Second)
+	case Minutes:
+		return z.Add(time.Duration(s.Count) * time.Minute)
+	case Hours:
+		return z.Add(time.Duration(s.Count) * time.Hour)
+	case Days:
+		return z.AddDate(0, 0, s.Count)
+	case Weeks:
+		return z.AddDate(0, 0, 7*s.Count)
+	case Months:
+		return z.AddDate(0, s.Count, 0)
+	case Years:
+		return z.AddDate(s.Count, 0, 0)
+	default:
+		return z
+	}
+}
+
+// String formats the span in ISO 8601 duration form, e.g. "P3D" or "PT90M".
+// Negative spans carry a leading minus, "-P3D", as in ISO 8601-2.
+func (s CalendarSpan) String() string {
+	sign, count := "", s.Count
+	if count < 0 {
+		sign, count = "-", -count
+	}
+
+	switch s.Unit {
+	case Seconds:
+		return fmt.Sprintf("%sPT%dS", sign, count)
+	case Minutes:
+		return fmt.Sprintf("%sPT%dM", sign, count)
+	case Hours:
+		return fmt.Sprintf("%sPT%dH", sign, count)
+	case Days:
+		return fmt.Sprintf("%sP%dD", sign, count)
+	case Weeks:
+		return fmt.Sprintf("%sP%dW", sign, count)
+	case Months:
+		return fmt.Sprintf("%sP%dM", sign, count)
+	case Years:
+		return fmt.Sprintf("%sP%dY", sign, count)
+	default:
+		return fmt.Sprintf("Span(%d, %d)", s.Count, int(s.Unit))
+	}
+}
+
+// intervalNames maps adjectives accepted by ParseInterval to their spans.
+var intervalNames = map[string]CalendarSpan{
+	"hourly":       {Count: 1, Unit: Hours},
+	"daily":        {Count: 1, Unit: Days},
+	"weekly":       {Count: 1, Unit: Weeks},
+	"biweekly":     {Count: 2, Unit: Weeks},
+	"fortnightly":  {Count: 2, Unit: Weeks},
+	"monthly":      {Count: 1, Unit: Months},
+	"quarterly":    {Count: 3, Unit: Months},
+	"semiannually": {Count: 6, Unit: Months},
+	"yearly":       {Count: 1, Unit: Years},
+	"annually":     {Count: 1, Unit: Years},
+}
+
+// intervalUnits maps unit words accepted by ParseInterval, singular and plural,
+// to a span of one such unit.
+var intervalUnits = map[string]CalendarSpan{
+	"second":  {Count: 1, Unit: Seconds},
+	"minute":  {Count: 1, Unit: Minutes},
+	"hour":    {Count: 1, Unit: Hours},
+	"day":     {Count: 1, Unit: Days},
+	"week":    {Count: 1, Unit: Weeks},
+	"month":   {Count: 1, Unit: Months},
+	"quarter": {Count: 3, Unit: Months},
+	"year":    {Count: 1, Unit: Years},
+}
+
+// ParseInterval parses a human-readable billing interval into a CalendarSpan
+// for use with CyclesEvery. Accepted forms are adjectives such as "monthly",
+// "quarterly" or "biweekly", and "every [n] <unit>" such as "every month" or
+// "every 3 months". Matching is case-insensitive; n must be positive.
+func ParseInterval(s string) (CalendarSpan, error) {
+	text := strings.ToLower(strings.Join(strings.Fields(s), " "))
+
+	if span, ok := intervalNames[text]; ok {
+		return span, nil
+	}
+
+	rest, ok := strings.CutPrefix(text, "every ")
+	if !ok {
+		return CalendarSpan{}, fmt.Errorf("%w: interval %q", ErrInvalidFormat, s)
+	}
+
+	count := 1
+	if number, unit, found := strings.Cut(rest, " "); found {
+		n, err := strconv.Atoi(number)
+		if err != nil || n <= 0 {
+			return CalendarSpan{}, fmt.Errorf("%w: interval %q", ErrInvalidFormat, s)
+		}
+		count, rest = n, unit
+	}
+
+	unit, ok := intervalUnits[rest]
+	if !ok {
+		unit, ok = intervalUnits[strings.TrimSuffix(rest, "s")]
+	}
+	if !ok {
+		return CalendarSpan{}, fmt.Errorf("%w: interval %q", ErrInvalidFormat, s)
+	}
+
+	return Span(count*unit.Count, unit.Unit), nil
+}
@@ -0,0 +1,139 @@
+package zeit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAddSpan(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		start    time.Time
+		expected time.Time
+		name     string
+		span     CalendarSpan
+	}{
+		{
+			name:     "Seconds",
+			start:    time.Date(2024, 1, 15, 10, 0, 0, 0, berlin),
+			span:     Span(90, Seconds),
+			expected: time.Date(2024, 1, 15, 10, 1, 30, 0, berlin),
+		},
+		{
+			name:     "Hours across DST are absolute",
+			start:    time.Date(2024, 3, 30, 12, 0, 0, 0, berlin),
+			span:     Span(24, Hours),
+			expected: time.Date(2024, 3, 31, 13, 0, 0, 0, berlin),
+		},
+		{
+			name:     "Days across DST keep wall clock",
+			start:    time.Date(2024, 3, 30, 12, 0, 0, 0, berlin),
+			span:     Span(1, Days),
+			expected: time.Date(2024, 3, 31, 12, 0, 0, 0, berlin),
+		},
+		{
+			name:     "Weeks",
+			start:    time.Date(2024, 1, 15, 10, 0, 0, 0, berlin),
+			span:     Span(2, Weeks),
+			expected: time.Date(2024, 1, 29, 10, 0, 0, 0, berlin),
+		},
+		{
+			name:     "Month end clamps",
+			start:    time.Date(2024, 1, 31, 10, 0, 0, 0, berlin),
+			span:     Span(1, Months),
+			expected: time.Date(2024, 2, 29, 10, 0, 0, 0, berlin),
+		},
+		{
+			name:     "Negative months",
+			start:    time.Date(2024, 3, 31, 10, 0, 0, 0, berlin),
+			span:     Span(-1, Months),
+			expected: time.Date(2024, 2, 29, 10, 0, 0, 0, berlin),
+		},
+		{
+			name:     "Leap day plus one year",
+			start:    time.Date(2024, 2, 29, 0, 0, 0, 0, berlin),
+			span:     Span(1, Years),
+			expected: time.Date(2025, 2, 28, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:     "Local month differs from UTC month",
+			start:    time.Date(2024, 1, 31, 0, 30, 0, 0, berlin), // Jan 30 23:30 UTC
+			span:     Span(1, Months),
+			expected: time.Date(2024, 2, 29, 0, 30, 0, 0, berlin),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := New(tt.start, berlin).AddSpan(tt.span)
+			if !result.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected.UTC(), result.instant)
+			}
+			if result.Location() != berlin {
+				t.Error("AddSpan should preserve location")
+			}
+		})
+	}
+}
+
+func TestCalendarSpan_String(t *testing.T) {
+	tests := []struct {
+		expected string
+		span     CalendarSpan
+	}{
+		{"PT30S", Span(30, Seconds)},
+		{"PT15M", Span(15, Minutes)},
+		{"PT2H", Span(2, Hours)},
+		{"P3D", Span(3, Days)},
+		{"P2W", Span(2, Weeks)},
+		{"P1M", Span(1, Months)},
+		{"P1Y", Span(1, Years)},
+		{"-P3D", Span(-3, Days)},
+		{"-PT90M", Span(-90, Minutes)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := tt.span.String(); result != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected CalendarSpan
+		wantErr  bool
+	}{
+		{input: "monthly", expected: Span(1, Months)},
+		{input: "Quarterly", expected: Span(3, Months)},
+		{input: "biweekly", expected: Span(2, Weeks)},
+		{input: "annually", expected: Span(1, Years)},
+		{input: "every month", expected: Span(1, Months)},
+		{input: "every 3 months", expected: Span(3, Months)},
+		{input: "  Every  2   Weeks ", expected: Span(2, Weeks)},
+		{input: "every 2 quarters", expected: Span(6, Months)},
+		{input: "every 1 day", expected: Span(1, Days)},
+		{input: "every 0 months", wantErr: true},
+		{input: "every -1 months", wantErr: true},
+		{input: "every 3 fortnights", wantErr: true},
+		{input: "every three months", wantErr: true},
+		{input: "3 months", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			span, err := ParseInterval(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseInterval() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && span != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, span)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package zeit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WeekRule defines a week numbering scheme: the day weeks start on, and how many
+// days of the new year week 1 must contain.
+type WeekRule struct {
+	FirstDay time.Weekday
+	MinDays  int
+}
+
+var (
+	// ISOWeek numbers weeks per ISO 8601: weeks start on Monday, week 1 contains Jan 4.
+	ISOWeek = WeekRule{FirstDay: time.Monday, MinDays: 4}
+	// USWeek numbers weeks the US way: weeks start on Sunday, week 1 contains Jan 1.
+	USWeek = WeekRule{FirstDay: time.Sunday, MinDays: 1}
+	// MiddleEastWeek numbers weeks starting on Saturday, week 1 contains Jan 1.
+	MiddleEastWeek = WeekRule{FirstDay: time.Saturday, MinDays: 1}
+)
+
+// StartOfWeek returns a new Zeit at midnight on the first day of the Zeit's week
+// according to rule, in the Zeit's timezone.
+func (z *Zeit) StartOfWeek(rule WeekRule) *Zeit {
+	start := rule.weekStart(civilDate(z.Time()))
+	return New(time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, z.location), z.location)
+}
+
+// WeekPeriod returns the Zeit's week according to rule as a half-open Period in its
+// timezone, from StartOfWeek to the same local midnight seven days later.
+func (z *Zeit) WeekPeriod(rule WeekRule) *Period {
+	start := z.StartOfWeek(rule)
+	local := start.Time()
+	return &Period{
+		StartsAt: start,
+		EndsAt:   New(time.Date(local.Year(), local.Month(), local.Day()+7, 0, 0, 0, 0, z.location), z.location),
+	}
+}
+
+// WeekOfYear returns the week-numbering year and week number (1-53) of the Zeit
+// according to rule, evaluated in the Zeit's timezone. Days around New Year may
+// belong to a week of the adjacent year, as with time.Time.ISOWeek.
+func (z *Zeit) WeekOfYear(rule WeekRule) (year, week int) {
+	date := civilDate(z.Time())
+
+	year = date.Year()
+	if date.Before(rule.week1Start(year)) {
+		year--
+	} else if !date.Before(rule.week1Start(year + 1)) {
+		year++
+	}
+
+	days := int(rule.weekStart(date).Sub(rule.week1Start(year)).Hours() / 24)
+	return year, days/7 + 1
+}
+
+// FormatISOWeekDate formats the Zeit as an ISO 8601 week date in its timezone,
+// e.g. "2024-W03-1" for Monday of week 3. The year is the ISO week-numbering
+// year, which differs from the calendar year around New Year.
+func (z *Zeit) FormatISOWeekDate() string {
+	year, week := z.WeekOfYear(ISOWeek)
+	day := int(z.Time().Weekday())
+	if day == 0 {
+		day = 7
+	}
+	return fmt.Sprintf("%04d-W%02d-%d", year, week, day)
+}
+
+// parseISOWeekDate parses an ISO 8601 week date, "2024-W03-1" or "2024W031",
+// as midnight in loc. Without the day ("2024-W03", "2024W03") it means Monday.
+func parseISOWeekDate(s string, loc *time.Location) (time.Time, error) {
+	invalid := fmt.Errorf("%w: ISO week date %q", ErrInvalidFormat, s)
+
+	compact := strings.ReplaceAll(s, "-", "")
+	if len(compact) != 7 && len(compact) != 8 || compact[4] != 'W' {
+		return time.Time{}, invalid
+	}
+
+	year, err := strconv.Atoi(compact[:4])
+	if err != nil {
+		return time.Time{}, invalid
+	}
+	week, err := strconv.Atoi(compact[5:7])
+	if err != nil {
+		return time.Time{}, invalid
+	}
+	day := 1
+	if len(compact) == 8 {
+		if day, err = strconv.Atoi(compact[7:]); err != nil {
+			return time.Time{}, invalid
+		}
+	}
+
+	if compact != s && s != isoWeekDateString(compact) {
+		return time.Time{}, invalid
+	}
+
+	start := ISOWeek.week1Start(year)
+	weeks := int(ISOWeek.week1Start(year+1).Sub(start).Hours()/24) / 7
+	if week < 1 || week > weeks || day < 1 || day > 7 {
+		return time.Time{}, invalid
+	}
+
+	date := start.AddDate(0, 0, 7*(week-1)+day-1)
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc), nil
+}
+
+// isoWeekDateString returns the extended form of a compact week date ("2024W031" -> "2024-W03-1").
+func isoWeekDateString(compact string) string {
+	extended := compact[:4] + "-" + compact[4:7]
+	if len(compact) == 8 {
+		extended += "-" + compact[7:]
+	}
+	return extended
+}
+
+// weekStart returns the first day of the week containing date.
+func (r WeekRule) weekStart(date time.Time) time.Time {
+	offset := (int(date.Weekday()) - int(r.FirstDay) + 7) % 7
+	return date.AddDate(0, 0, -offset)
+}
+
+// week1Start returns the first day of week 1 of year: the week that contains
+// the MinDays-th of January.
+func (r WeekRule) week1Start(year int) time.Time {
+	minDays := max(r.MinDays, 1)
+	return r.weekStart(time.Date(year, time.January, minDays, 0, 0, 0, 0, time.UTC))
+}
+
+// civilDate returns t's calendar date as midnight UTC, for DST-free day arithmetic.
+func civilDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,190 @@
+package zeit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekOfYear_MatchesISOWeek(t *testing.T) {
+	start := time.Date(2019, 12, 20, 12, 0, 0, 0, time.UTC)
+
+	for i := range 6 * 366 {
+		d := start.AddDate(0, 0, i)
+		wantYear, wantWeek := d.ISOWeek()
+		year, week := New(d, time.UTC).WeekOfYear(ISOWeek)
+		if year != wantYear || week != wantWeek {
+			t.Fatalf("%v: expected %d-W%02d, got %d-W%02d", d, wantYear, wantWeek, year, week)
+		}
+	}
+}
+
+func TestWeekOfYear_Rules(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		name     string
+		rule     WeekRule
+		wantYear int
+		wantWeek int
+	}{
+		// Jan 1, 2022 is a Saturday
+		{time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), "ISO Saturday Jan 1", ISOWeek, 2021, 52},
+		{time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), "US Saturday Jan 1", USWeek, 2022, 1},
+		{time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), "US Sunday Jan 2", USWeek, 2022, 2},
+		{time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), "Middle East Saturday Jan 1", MiddleEastWeek, 2022, 1},
+		{time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC), "Middle East Friday Jan 7", MiddleEastWeek, 2022, 1},
+		{time.Date(2022, 1, 8, 0, 0, 0, 0, time.UTC), "Middle East Saturday Jan 8", MiddleEastWeek, 2022, 2},
+		// Dec 31, 2024 is a Tuesday: the US week containing Jan 1, 2025 is week 1
+		{time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), "US year end", USWeek, 2025, 1},
+		{time.Date(2024, 12, 28, 0, 0, 0, 0, time.UTC), "US last full week", USWeek, 2024, 52},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year, week := New(tt.date, time.UTC).WeekOfYear(tt.rule)
+			if year != tt.wantYear || week != tt.wantWeek {
+				t.Errorf("Expected %d week %d, got %d week %d", tt.wantYear, tt.wantWeek, year, week)
+			}
+		})
+	}
+}
+
+func TestWeekOfYear_Timezone(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	// Sunday 20:00 UTC is already Monday in Tokyo
+	z := New(time.Date(2024, 1, 7, 20, 0, 0, 0, time.UTC), tokyo)
+
+	if _, week := z.WeekOfYear(ISOWeek); week != 2 {
+		t.Errorf("Expected week 2 in Tokyo, got %d", week)
+	}
+}
+
+func TestStartOfWeek(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	// Wednesday Jan 17, 2024 15:00 in Berlin
+	z := New(time.Date(2024, 1, 17, 15, 0, 0, 0, berlin), berlin)
+
+	tests := []struct {
+		expected time.Time
+		name     string
+		rule     WeekRule
+	}{
+		{time.Date(2024, 1, 15, 0, 0, 0, 0, berlin), "ISO", ISOWeek},
+		{time.Date(2024, 1, 14, 0, 0, 0, 0, berlin), "US", USWeek},
+		{time.Date(2024, 1, 13, 0, 0, 0, 0, berlin), "Middle East", MiddleEastWeek},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := z.StartOfWeek(tt.rule)
+			if !start.Time().Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, start.Time())
+			}
+			if start.Location() != berlin {
+				t.Error("StartOfWeek should preserve timezone")
+			}
+		})
+	}
+}
+
+func TestWeekPeriod(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		at    time.Time
+		start time.Time
+		end   time.Time
+		name  string
+		rule  WeekRule
+	}{
+		{
+			name:  "ISO",
+			at:    time.Date(2024, 1, 17, 15, 0, 0, 0, berlin),
+			rule:  ISOWeek,
+			start: time.Date(2024, 1, 15, 0, 0, 0, 0, berlin),
+			end:   time.Date(2024, 1, 22, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:  "US",
+			at:    time.Date(2024, 1, 17, 15, 0, 0, 0, berlin),
+			rule:  USWeek,
+			start: time.Date(2024, 1, 14, 0, 0, 0, 0, berlin),
+			end:   time.Date(2024, 1, 21, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:  "Week with DST change",
+			at:    time.Date(2024, 3, 27, 12, 0, 0, 0, berlin),
+			rule:  ISOWeek,
+			start: time.Date(2024, 3, 25, 0, 0, 0, 0, berlin),
+			end:   time.Date(2024, 4, 1, 0, 0, 0, 0, berlin),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := New(tt.at, berlin)
+			p := z.WeekPeriod(tt.rule)
+
+			if !p.StartsAt.instant.Equal(tt.start) || !p.EndsAt.instant.Equal(tt.end) {
+				t.Errorf("Expected %v to %v, got %v to %v", tt.start, tt.end, p.StartsAt.Time(), p.EndsAt.Time())
+			}
+			if !p.Contains(z) || p.Contains(p.EndsAt) {
+				t.Error("Week period should be half-open and contain the Zeit")
+			}
+		})
+	}
+}
+
+func TestFormatISOWeekDate(t *testing.T) {
+	tests := []struct {
+		time     time.Time
+		expected string
+	}{
+		{time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), "2024-W03-1"},
+		{time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), "2025-W01-1"},
+		{time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC), "2020-W53-7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := New(tt.time, time.UTC).FormatISOWeekDate(); result != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFromUser_ISOWeekDate(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		expected time.Time
+		input    string
+		wantErr  bool
+	}{
+		{input: "2024-W03-1", expected: time.Date(2024, 1, 15, 0, 0, 0, 0, berlin)},
+		{input: "2024W031", expected: time.Date(2024, 1, 15, 0, 0, 0, 0, berlin)},
+		{input: "2024-W03", expected: time.Date(2024, 1, 15, 0, 0, 0, 0, berlin)},
+		{input: "2025-W01-1", expected: time.Date(2024, 12, 30, 0, 0, 0, 0, berlin)},
+		{input: "2020-W53-7", expected: time.Date(2021, 1, 3, 0, 0, 0, 0, berlin)},
+		{input: "2024-W53-1", wantErr: true},
+		{input: "2024-W03-8", wantErr: true},
+		{input: "2024-W00-1", wantErr: true},
+		{input: "2024W03-1", wantErr: true},
+		{input: "2024-Wxx-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			z, err := FromUser(tt.input, berlin)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromUser() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !z.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected.UTC(), z.instant)
+			}
+		})
+	}
+}
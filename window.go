@@ -0,0 +1,62 @@
+package zeit
+
+import "time"
+
+// Window returns the fixed window of the given size that contains now.
+// Windows are aligned to origin: they start at origin + k*size for whole k,
+// including windows before origin. A nil origin aligns to the Unix epoch, so a
+// one-hour window starts on the full UTC hour. The Period is in now's timezone.
+// Returns nil if size is not positive.
+func Window(now *Zeit, size time.Duration, origin *Zeit) *Period {
+	if size <= 0 {
+		return nil
+	}
+
+	anchor := time.Unix(0, 0).UTC()
+	if origin != nil {
+		anchor = origin.instant
+	}
+
+	elapsed := now.instant.Sub(anchor)
+	offset := elapsed % size
+	if offset < 0 {
+		offset += size
+	}
+
+	start := New(now.instant.Add(-offset), now.location)
+	return &Period{
+		StartsAt: start,
+		EndsAt:   start.Add(size),
+	}
+}
+
+// NextResetAt returns when the fixed window containing now ends and the next begins.
+// Returns nil if size is not positive.
+func NextResetAt(now *Zeit, size time.Duration, origin *Zeit) *Zeit {
+	w := Window(now, size, origin)
+	if w == nil {
+		return nil
+	}
+	return w.EndsAt
+}
+
+// SlidingWindows generates count windows of the given size starting at the Zeit,
+// each starting step after the previous one. Windows overlap when step < size,
+// e.g. rolling 30-day windows advanced daily for usage calculations.
+// Returns an empty slice if count, size or step is not positive.
+func (z *Zeit) SlidingWindows(size, step time.Duration, count int) []*Period {
+	if count <= 0 || size <= 0 || step <= 0 {
+		return []*Period{}
+	}
+
+	periods := make([]*Period, count)
+	for i := range count {
+		start := z.Add(time.Duration(i) * step)
+		periods[i] = &Period{
+			StartsAt: start,
+			EndsAt:   start.Add(size),
+		}
+	}
+
+	return periods
+}
@@ -0,0 +1,134 @@
+package zeit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWindow(t *testing.T) {
+	origin := New(time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		now    time.Time
+		origin *Zeit
+		start  time.Time
+		name   string
+		size   time.Duration
+	}{
+		{
+			name:  "Epoch aligned hour",
+			now:   time.Date(2024, 1, 15, 10, 42, 17, 0, time.UTC),
+			size:  time.Hour,
+			start: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "Origin aligned",
+			now:    time.Date(2024, 1, 15, 9, 20, 0, 0, time.UTC),
+			origin: origin,
+			size:   15 * time.Minute,
+			start:  time.Date(2024, 1, 15, 9, 15, 0, 0, time.UTC),
+		},
+		{
+			name:   "On window boundary",
+			now:    time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC),
+			origin: origin,
+			size:   15 * time.Minute,
+			start:  time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			name:   "Before origin",
+			now:    time.Date(2024, 1, 15, 8, 50, 0, 0, time.UTC),
+			origin: origin,
+			size:   15 * time.Minute,
+			start:  time.Date(2024, 1, 15, 8, 45, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := Window(New(tt.now, time.UTC), tt.size, tt.origin)
+
+			if !w.StartsAt.instant.Equal(tt.start) {
+				t.Errorf("Expected start %v, got %v", tt.start, w.StartsAt.instant)
+			}
+			if w.Duration() != tt.size {
+				t.Errorf("Expected window of %v, got %v", tt.size, w.Duration())
+			}
+			if !w.Contains(New(tt.now, time.UTC)) {
+				t.Error("Window should contain now")
+			}
+		})
+	}
+}
+
+func TestNextResetAt(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	now := New(time.Date(2024, 1, 15, 10, 42, 0, 0, time.UTC), tokyo)
+
+	reset := NextResetAt(now, time.Hour, nil)
+
+	expected := time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)
+	if !reset.instant.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, reset.instant)
+	}
+	if reset.Location() != tokyo {
+		t.Error("NextResetAt should keep now's location")
+	}
+}
+
+func TestWindow_InvalidSize(t *testing.T) {
+	now := Now(time.UTC)
+
+	if w := Window(now, 0, nil); w != nil {
+		t.Error("Expected nil window for zero size")
+	}
+	if reset := NextResetAt(now, -time.Minute, nil); reset != nil {
+		t.Error("Expected nil reset for negative size")
+	}
+}
+
+func TestSlidingWindows(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	day := 24 * time.Hour
+
+	windows := start.SlidingWindows(30*day, day, 3)
+
+	if len(windows) != 3 {
+		t.Fatalf("Expected 3 windows, got %d", len(windows))
+	}
+	for i, w := range windows {
+		expectedStart := time.Date(2024, 1, 1+i, 0, 0, 0, 0, time.UTC)
+		if !w.StartsAt.instant.Equal(expectedStart) {
+			t.Errorf("Window %d: expected start %v, got %v", i, expectedStart, w.StartsAt.instant)
+		}
+		if w.Duration() != 30*day {
+			t.Errorf("Window %d: expected 30 days, got %v", i, w.Duration())
+		}
+	}
+	if !windows[0].Overlaps(windows[2]) {
+		t.Error("Rolling windows should overlap")
+	}
+}
+
+func TestSlidingWindows_Invalid(t *testing.T) {
+	start := Now(time.UTC)
+
+	tests := []struct {
+		name  string
+		size  time.Duration
+		step  time.Duration
+		count int
+	}{
+		{"Zero count", time.Hour, time.Minute, 0},
+		{"Zero size", 0, time.Minute, 3},
+		{"Zero step", time.Hour, 0, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if windows := start.SlidingWindows(tt.size, tt.step, tt.count); len(windows) != 0 {
+				t.Errorf("Expected 0 windows, got %d", len(windows))
+			}
+		})
+	}
+}
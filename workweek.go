@@ -0,0 +1,94 @@
+package zeit
+
+import (
+	"math/bits"
+	"time"
+)
+
+// Weekdays is a set of days of the week, e.g. the working days of a region.
+// Bit n is set for time.Weekday(n), so masks combine with | and &.
+type Weekdays uint8
+
+const (
+	// MonToFri is the Monday to Friday work week used by BusinessDays and Calendar.
+	MonToFri Weekdays = 1<<time.Monday | 1<<time.Tuesday | 1<<time.Wednesday | 1<<time.Thursday | 1<<time.Friday
+	// SunToThu is the Sunday to Thursday work week common in the Middle East.
+	SunToThu Weekdays = 1<<time.Sunday | 1<<time.Monday | 1<<time.Tuesday | 1<<time.Wednesday | 1<<time.Thursday
+	// MonToSat is a six-day work week.
+	MonToSat Weekdays = MonToFri | 1<<time.Saturday
+	// Weekend is Saturday and Sunday.
+	Weekend Weekdays = 1<<time.Saturday | 1<<time.Sunday
+)
+
+// WeekdaysOf returns the set of the given days.
+func WeekdaysOf(days ...time.Weekday) Weekdays {
+	var w Weekdays
+	for _, d := range days {
+		w |= 1 << d
+	}
+	return w
+}
+
+// Contains reports whether day is in the set.
+func (w Weekdays) Contains(day time.Weekday) bool {
+	return w&(1<<day) != 0
+}
+
+// Len returns the number of days in the set.
+func (w Weekdays) Len() int {
+	return bits.OnesCount8(uint8(w & (1<<7 - 1)))
+}
+
+// AddBusinessDaysOn is like AddBusinessDays with workweek as the business days,
+// e.g. SunToThu for a regional override without building a Calendar.
+// Days are counted on the Zeit's local calendar and the local time of day is kept,
+// as with Calendar.AddBusinessDays. Negative values count backwards. Zero, or an
+// empty workweek, returns the Zeit unchanged.
+func (z *Zeit) AddBusinessDaysOn(days int, workweek Weekdays) *Zeit {
+	if workweek.Len() == 0 {
+		return z
+	}
+
+	current := z.Time()
+	direction := 1
+	if days < 0 {
+		direction = -1
+		days = -days
+	}
+
+	for i := 0; i < days; {
+		current = current.AddDate(0, 0, direction)
+		if workweek.Contains(current.Weekday()) {
+			i++
+		}
+	}
+
+	return New(current, z.location)
+}
+
+// BusinessDaysOn is like BusinessDays with workweek as the business days.
+// Runs in constant time regardless of the length of the duration.
+func (d *Duration) BusinessDaysOn(workweek Weekdays) int {
+	startDate, endDate := d.dates()
+	return workweek.countBetween(startDate, endDate)
+}
+
+// countBetween counts the days in [startDate, endDate), both midnights, whose
+// weekday is in the set. Returns 0 if endDate is not after startDate.
+func (w Weekdays) countBetween(startDate, endDate time.Time) int {
+	if !startDate.Before(endDate) {
+		return 0
+	}
+
+	totalDays := int(endDate.Sub(startDate).Hours() / 24)
+	count := totalDays / 7 * w.Len()
+
+	first := int(startDate.Weekday())
+	for i := range totalDays % 7 {
+		if w.Contains(time.Weekday((first + i) % 7)) {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,162 @@
+package zeit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekdaysOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		days     []time.Weekday
+		expected Weekdays
+		length   int
+	}{
+		{
+			name:     "Monday to Friday",
+			days:     []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+			expected: MonToFri,
+			length:   5,
+		},
+		{
+			name:     "Sunday to Thursday",
+			days:     []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday},
+			expected: SunToThu,
+			length:   5,
+		},
+		{
+			name:     "Duplicates",
+			days:     []time.Weekday{time.Saturday, time.Sunday, time.Saturday},
+			expected: Weekend,
+			length:   2,
+		},
+		{
+			name:     "Empty",
+			expected: 0,
+			length:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := WeekdaysOf(tt.days...)
+			if w != tt.expected {
+				t.Errorf("Expected %b, got %b", tt.expected, w)
+			}
+			if w.Len() != tt.length {
+				t.Errorf("Expected %d days, got %d", tt.length, w.Len())
+			}
+			for _, d := range tt.days {
+				if !w.Contains(d) {
+					t.Errorf("Expected set to contain %v", d)
+				}
+			}
+		})
+	}
+
+	if MonToSat != MonToFri|WeekdaysOf(time.Saturday) {
+		t.Error("MonToSat should be MonToFri plus Saturday")
+	}
+	if MonToFri.Contains(time.Saturday) || SunToThu.Contains(time.Friday) {
+		t.Error("Work weeks should not contain their weekend days")
+	}
+}
+
+func TestAddBusinessDaysOn(t *testing.T) {
+	// Thursday, January 18, 2024
+	thursday := time.Date(2024, 1, 18, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		start    time.Time
+		expected time.Time
+		name     string
+		workweek Weekdays
+		days     int
+	}{
+		{
+			name:     "MonToFri skips the weekend",
+			start:    thursday,
+			workweek: MonToFri,
+			days:     2,
+			expected: time.Date(2024, 1, 22, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "SunToThu skips Friday and Saturday",
+			start:    thursday,
+			workweek: SunToThu,
+			days:     1,
+			expected: time.Date(2024, 1, 21, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "MonToSat counts Saturday",
+			start:    thursday,
+			workweek: MonToSat,
+			days:     2,
+			expected: time.Date(2024, 1, 20, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Backwards",
+			start:    time.Date(2024, 1, 21, 10, 0, 0, 0, time.UTC),
+			workweek: SunToThu,
+			days:     -1,
+			expected: thursday,
+		},
+		{
+			name:     "Empty workweek",
+			start:    thursday,
+			workweek: 0,
+			days:     3,
+			expected: thursday,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := New(tt.start, time.UTC).AddBusinessDaysOn(tt.days, tt.workweek)
+			if !got.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got.instant)
+			}
+		})
+	}
+}
+
+func TestAddBusinessDaysOn_LocalCalendar(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	// Friday 23:30 UTC is already Saturday in Tokyo
+	z := New(time.Date(2024, 1, 19, 23, 30, 0, 0, time.UTC), tokyo)
+
+	got := z.AddBusinessDaysOn(1, MonToFri)
+
+	expected := time.Date(2024, 1, 22, 8, 30, 0, 0, tokyo)
+	if !got.instant.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, got.Time())
+	}
+}
+
+func TestDuration_BusinessDaysOn(t *testing.T) {
+	// Monday, January 1, 2024
+	monday := New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		name     string
+		workweek Weekdays
+		days     int
+		expected int
+	}{
+		{name: "MonToFri matches BusinessDays", workweek: MonToFri, days: 31, expected: 23},
+		{name: "SunToThu", workweek: SunToThu, days: 31, expected: 23},
+		{name: "MonToSat", workweek: MonToSat, days: 31, expected: 27},
+		{name: "Weekend only", workweek: Weekend, days: 14, expected: 4},
+		{name: "Empty workweek", workweek: 0, days: 31, expected: 0},
+		{name: "Empty duration", workweek: MonToFri, days: 0, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := monday.Until(monday.AddDays(tt.days))
+			if got := d.BusinessDaysOn(tt.workweek); got != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
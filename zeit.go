@@ -4,11 +4,28 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"io"
+	"strconv"
+	"strings"
 	"time"
 )
 
 // Zeit represents a moment in time with timezone awareness.
 // Stores time as UTC internally but preserves user's timezone for display.
+//
+// A Zeit is immutable: methods return a new Zeit and never modify the receiver,
+// so values can be shared freely. The only exceptions are the decoding methods
+// Scan, UnmarshalJSON and UnmarshalGQL, which fill in the receiver.
+// A Zeit holds no caches or lazily derived state and is safe for concurrent
+// use; any added later must be synchronized (see race_test.go).
+//
+// Arithmetic comes in two families. Absolute methods (Add, Sub, AddDays, SubDays,
+// AddWeeks, AddChecked, AddDaysChecked) move by elapsed time, with a day being
+// exactly 24 hours, so the local wall-clock time shifts by an hour across a DST
+// change. Calendar methods (AddDate, AddSpan, AddDuration, AddBusinessDaysOn and
+// Calendar.AddBusinessDays) move the date on the Zeit's local calendar and keep
+// the wall-clock time; see AddDate for how times that a DST change skips or
+// repeats are resolved.
 type Zeit struct {
 	instant  time.Time
 	location *time.Location
@@ -25,16 +42,85 @@ func New(t time.Time, loc *time.Location) *Zeit {
 	}
 }
 
-// Now creates a Zeit representing the current moment in the given location.
+// Date creates a Zeit from calendar components in loc, mirroring time.Date:
+//
+//	zeit.Date(2024, time.March, 15, 9, 30, 0, 0, berlin)
+//
+// Out-of-range values are normalized as by time.Date, so October 32 becomes
+// November 1. Unlike time.Date, wall-clock times around DST changes resolve
+// like AddDate: a time skipped by the change moves forward by the gap and a
+// repeated time resolves to its earlier instant. A nil loc defaults to UTC.
+func Date(year int, month time.Month, day, hour, minute, sec, nsec int, loc *time.Location) *Zeit {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return New(localTime(year, month, day, hour, minute, sec, nsec, loc), loc)
+}
+
+// Clone returns a copy of the Zeit that shares nothing with the receiver.
+// Returns nil for a nil Zeit.
+func (z *Zeit) Clone() *Zeit {
+	if z == nil {
+		return nil
+	}
+	return &Zeit{instant: z.instant, location: z.location}
+}
+
+// Now creates a Zeit representing the current moment in the given location,
+// corrected by the OffsetProvider registered with SetOffsetProvider, if any.
 func Now(loc *time.Location) *Zeit {
 	if loc == nil {
 		loc = time.UTC
 	}
-	return New(time.Now(), loc)
+	return New(now(), loc)
+}
+
+// Today returns the start of the current day in loc (local midnight).
+// Like Now, it applies the registered clock correction. A nil loc defaults to UTC.
+func Today(loc *time.Location) *Zeit {
+	return Now(loc).StartOfDay()
+}
+
+// Yesterday returns the start of the day before today in loc.
+func Yesterday(loc *time.Location) *Zeit {
+	return Now(loc).addLocalDays(-1)
+}
+
+// Tomorrow returns the start of the day after today in loc.
+func Tomorrow(loc *time.Location) *Zeit {
+	return Now(loc).addLocalDays(1)
+}
+
+// LoadLocation is like time.LoadLocation but wraps failures in ErrUnknownTimezone,
+// so callers can tell a bad zone name apart from other errors with errors.Is.
+func LoadLocation(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", ErrUnknownTimezone, name, err)
+	}
+	return loc, nil
+}
+
+// MustLocation is like LoadLocation but panics if the zone is unknown, for
+// package-level configuration:
+//
+//	var appTZ = zeit.MustLocation("Europe/Berlin")
+//
+// The panic value is the error from LoadLocation.
+func MustLocation(name string) *time.Location {
+	loc, err := LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
 }
 
 // FromUser parses an ISO 8601 string and creates a Zeit.
 // Expects RFC3339 format: "2006-01-02T15:04:05Z07:00"
+// ISO week dates ("2024-W03-1") and ordinal dates ("2024-046") are also
+// accepted and mean midnight in loc.
+// Returns ErrInvalidFormat for unparseable strings and ErrOutOfRange for
+// times outside the configured valid range.
 func FromUser(isoString string, loc *time.Location) (*Zeit, error) {
 	if loc == nil {
 		loc = time.UTC
@@ -44,12 +130,65 @@ func FromUser(isoString string, loc *time.Location) (*Zeit, error) {
 	if err != nil {
 		// Try RFC3339Nano for fractional seconds
 		t, err = time.Parse(time.RFC3339Nano, isoString)
-		if err != nil {
-			return nil, err
+	}
+	if err != nil {
+		switch {
+		case strings.Contains(isoString, "W"):
+			t, err = parseISOWeekDate(isoString, loc)
+		case isOrdinalDate(isoString):
+			t, err = parseOrdinalDate(isoString, loc)
+		default:
+			err = fmt.Errorf("%w: %w", ErrInvalidFormat, err)
 		}
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	z := New(t, loc)
+	if err := z.Validate(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// MustFromUser is like FromUser but panics if the string cannot be parsed, for
+// test fixtures and constants known to be valid. The panic value is the error
+// from FromUser. Never use it on user input.
+func MustFromUser(isoString string, loc *time.Location) *Zeit {
+	z, err := FromUser(isoString, loc)
+	if err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// FromDateTimeLocal parses the value of an HTML datetime-local input, such as
+// "2024-01-15T10:30" or "2024-01-15T10:30:45.5", as wall-clock time in loc.
+// The input carries no timezone, so loc is required and must be the user's zone,
+// not a server default. Times that fall into a DST gap or overlap resolve as
+// with time.Date. The result is checked against the valid range like FromUser.
+// Returns ErrUnknownTimezone if loc is nil.
+func FromDateTimeLocal(s string, loc *time.Location) (*Zeit, error) {
+	if loc == nil {
+		return nil, fmt.Errorf("%w: FromDateTimeLocal requires a location", ErrUnknownTimezone)
+	}
+
+	layout := "2006-01-02T15:04"
+	if len(s) > len(layout) {
+		layout = "2006-01-02T15:04:05"
+	}
 
-	return New(t, loc), nil
+	t, err := time.ParseInLocation(layout, s, loc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: datetime-local %q", ErrInvalidFormat, s)
+	}
+
+	z := New(t, loc)
+	if err := z.Validate(); err != nil {
+		return nil, err
+	}
+	return z, nil
 }
 
 // FromDatabase creates a Zeit from a Unix timestamp (int64).
@@ -66,20 +205,140 @@ func (z *Zeit) ToDatabase() int64 {
 }
 
 // ToUser converts Zeit to ISO 8601 format string in the Zeit's timezone.
+// A nil Zeit returns an empty string, so optional fields render blank in templates.
 func (z *Zeit) ToUser() string {
+	if z == nil {
+		return ""
+	}
 	return z.instant.In(z.location).Format(time.RFC3339)
 }
 
-// Add returns a new Zeit with the duration added.
+// Precision selects how many fractional digits ToUserPrecision writes.
+type Precision int
+
+const (
+	// PrecisionSecond writes whole seconds, like ToUser: "2024-01-15T10:30:00+01:00".
+	PrecisionSecond Precision = iota
+	// PrecisionMinute omits seconds: "2024-01-15T10:30+01:00".
+	PrecisionMinute
+	// PrecisionMilli writes three fractional digits: "2024-01-15T10:30:00.000+01:00".
+	PrecisionMilli
+	// PrecisionMicro writes six fractional digits.
+	PrecisionMicro
+	// PrecisionNano writes nine fractional digits.
+	PrecisionNano
+)
+
+// precisionLayouts holds the layout of each Precision, indexed by value.
+var precisionLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05.000Z07:00",
+	"2006-01-02T15:04:05.000000Z07:00",
+	"2006-01-02T15:04:05.000000000Z07:00",
+}
+
+// ToUserPrecision is like ToUser with a fixed precision, so API responses have a
+// consistent width instead of consumers slicing strings. Extra digits are truncated,
+// not rounded. PrecisionMinute output is valid ISO 8601 but not RFC 3339, so FromUser
+// does not accept it. Unknown precisions format like ToUser.
+// A nil Zeit returns an empty string.
+func (z *Zeit) ToUserPrecision(p Precision) string {
+	if z == nil {
+		return ""
+	}
+	if p < 0 || int(p) >= len(precisionLayouts) {
+		p = PrecisionSecond
+	}
+	return z.instant.In(z.location).Format(precisionLayouts[p])
+}
+
+// Add returns a new Zeit with the duration added. The duration is elapsed time,
+// so adding 24 hours across a DST change lands at a different local time.
 func (z *Zeit) Add(d time.Duration) *Zeit {
 	return New(z.instant.Add(d), z.location)
 }
 
+// Sub returns a new Zeit with the duration subtracted.
+// Unlike time.Time.Sub it does not measure between two times; use Until for that.
+func (z *Zeit) Sub(d time.Duration) *Zeit {
+	return New(z.instant.Add(-d), z.location)
+}
+
 // AddDays returns a new Zeit with the specified number of days added.
+// Days are exactly 24 hours, counted on the UTC calendar, so across a DST change
+// the local wall-clock time shifts by an hour: 12:00 Berlin plus one day over the
+// spring change is 13:00. Use AddDate(0, 0, days) to keep the local time.
 func (z *Zeit) AddDays(days int) *Zeit {
 	return New(z.instant.AddDate(0, 0, days), z.location)
 }
 
+// SubDays returns a new Zeit with the specified number of days subtracted,
+// as 24-hour days like AddDays.
+func (z *Zeit) SubDays(days int) *Zeit {
+	return z.AddDays(-days)
+}
+
+// AddWeeks returns a new Zeit with the specified number of weeks added, counted
+// as seven days each like AddDays.
+func (z *Zeit) AddWeeks(weeks int) *Zeit {
+	return z.AddDays(7 * weeks)
+}
+
+// AddDate returns a new Zeit moved by years, months and days on the Zeit's local
+// calendar, keeping the local wall-clock time across DST changes. Years and months
+// are applied first and clamp to the end of the target month (Jan 31 + 1 month =
+// Feb 29), then days are added.
+//
+// If a DST change skips the wall-clock time on the target date, the result moves
+// forward by the length of the gap (02:30 on the spring change in Berlin becomes
+// 03:30). If a DST change repeats it, the result is the earlier of the two instants.
+func (z *Zeit) AddDate(years, months, days int) *Zeit {
+	t := z.Time()
+	first := time.Date(t.Year(), t.Month()+time.Month(12*years+months), 1, 0, 0, 0, 0, time.UTC)
+	day := min(t.Day(), daysIn(first.Year(), first.Month())) + days
+	return New(localTime(first.Year(), first.Month(), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), z.location), z.location)
+}
+
+// AddChecked is like Add but returns ErrOutOfRange instead of a wrapped-around
+// result when the sum exceeds the range time.Time can represent, or falls
+// outside the configured valid range.
+func (z *Zeit) AddChecked(d time.Duration) (*Zeit, error) {
+	result := z.instant.Add(d)
+	if result.Sub(z.instant) != d {
+		return nil, fmt.Errorf("%w: %s plus %v", ErrOutOfRange, z.ToUser(), d)
+	}
+
+	checked := New(result, z.location)
+	if err := checked.Validate(); err != nil {
+		return nil, err
+	}
+	return checked, nil
+}
+
+// AddDaysChecked is like AddDays but returns ErrOutOfRange instead of a
+// wrapped-around result when the date exceeds the range of Unix timestamps,
+// or falls outside the configured valid range.
+func (z *Zeit) AddDaysChecked(days int) (*Zeit, error) {
+	start := z.instant.Unix()
+	delta := int64(days) * 86400
+	sum := start + delta
+	if delta/86400 != int64(days) || (delta > 0 && sum < start) || (delta < 0 && sum > start) {
+		return nil, fmt.Errorf("%w: %s plus %d days", ErrOutOfRange, z.ToUser(), days)
+	}
+
+	result := z.instant.AddDate(0, 0, days)
+	if result.Unix() != sum {
+		return nil, fmt.Errorf("%w: %s plus %d days", ErrOutOfRange, z.ToUser(), days)
+	}
+
+	checked := New(result, z.location)
+	if err := checked.Validate(); err != nil {
+		return nil, err
+	}
+	return checked, nil
+}
+
 // AddBusinessDays returns a new Zeit with business days added (skips weekends).
 // Business days are Monday-Friday. Saturday and Sunday are skipped.
 func (z *Zeit) AddBusinessDays(days int) *Zeit {
@@ -118,8 +377,11 @@ func (z *Zeit) Unix() int64 {
 }
 
 // Format returns a formatted string representation using the given layout.
-// The time is formatted in the Zeit's timezone.
+// The time is formatted in the Zeit's timezone. A nil Zeit returns an empty string.
 func (z *Zeit) Format(layout string) string {
+	if z == nil {
+		return ""
+	}
 	return z.instant.In(z.location).Format(layout)
 }
 
@@ -138,6 +400,70 @@ func (z *Zeit) Equal(other *Zeit) bool {
 	return z.instant.Equal(other.instant)
 }
 
+// EqualWithin reports whether z and other are at most tolerance apart, so that
+// clock skew between machines doesn't make the same event look like two.
+// A negative tolerance is treated as its absolute value.
+func (z *Zeit) EqualWithin(other *Zeit, tolerance time.Duration) bool {
+	diff := z.instant.Sub(other.instant)
+	return diff.Abs() <= tolerance.Abs()
+}
+
+// ApproximatelyBefore reports whether z is before other by more than tolerance.
+// Instants within tolerance of each other count as simultaneous, so a few seconds
+// of clock skew don't flip ordering decisions: neither is approximately before
+// the other. A negative tolerance is treated as its absolute value.
+func (z *Zeit) ApproximatelyBefore(other *Zeit, tolerance time.Duration) bool {
+	return other.instant.Sub(z.instant) > tolerance.Abs()
+}
+
+// SameDay reports whether z and other fall on the same calendar date in loc.
+// A nil loc uses z's timezone.
+func (z *Zeit) SameDay(other *Zeit, loc *time.Location) bool {
+	a, b := z.inPair(other, loc)
+	return a.YearDay() == b.YearDay() && a.Year() == b.Year()
+}
+
+// SameMonth reports whether z and other fall in the same month of the same year in loc.
+// A nil loc uses z's timezone.
+func (z *Zeit) SameMonth(other *Zeit, loc *time.Location) bool {
+	a, b := z.inPair(other, loc)
+	return a.Month() == b.Month() && a.Year() == b.Year()
+}
+
+// SameYear reports whether z and other fall in the same calendar year in loc.
+// A nil loc uses z's timezone.
+func (z *Zeit) SameYear(other *Zeit, loc *time.Location) bool {
+	a, b := z.inPair(other, loc)
+	return a.Year() == b.Year()
+}
+
+// CalendarDaysBetween counts the local calendar-date changes from a to b in loc:
+// Mon 23:00 to Tue 01:00 is 1 day, and Mon 01:00 to Mon 23:00 is 0 days. Unlike
+// Duration.Days, which needs 24 full hours per day, this is the count for hotel
+// nights and per-diem rules. It is negative if b falls on an earlier date.
+// A nil loc uses a's timezone.
+func CalendarDaysBetween(a, b *Zeit, loc *time.Location) int {
+	x, y := a.inPair(b, loc)
+	return int(civilDate(y).Sub(civilDate(x)) / (24 * time.Hour))
+}
+
+// Nights returns the number of nights of a stay from checkIn to checkOut in loc,
+// the hotel's timezone: the local dates passed, regardless of the check-in and
+// check-out times. A late arrival at 23:00 leaving at 01:00 the next morning stays
+// one night; a day use stays none. A checkOut before checkIn returns 0.
+// A nil loc uses checkIn's timezone.
+func Nights(checkIn, checkOut *Zeit, loc *time.Location) int {
+	return max(CalendarDaysBetween(checkIn, checkOut, loc), 0)
+}
+
+// inPair returns z and other as time.Time in loc, or in z's timezone if loc is nil.
+func (z *Zeit) inPair(other *Zeit, loc *time.Location) (time.Time, time.Time) {
+	if loc == nil {
+		loc = z.location
+	}
+	return z.instant.In(loc), other.instant.In(loc)
+}
+
 // In returns a new Zeit with the same instant but a different timezone.
 // Useful for switching from UTC (database) to user display timezone.
 func (z *Zeit) In(loc *time.Location) *Zeit {
@@ -150,9 +476,24 @@ func (z *Zeit) In(loc *time.Location) *Zeit {
 	}
 }
 
+// Convert formats z in each of the given timezones, keyed by zone name.
+// Each value is RFC3339 with that zone's offset at the instant, so DST is
+// applied per zone. Useful for showing a meeting time to every attendee.
+func Convert(z *Zeit, locs []*time.Location) map[string]string {
+	result := make(map[string]string, len(locs))
+	for _, loc := range locs {
+		converted := z.In(loc)
+		result[converted.location.String()] = converted.ToUser()
+	}
+	return result
+}
+
 // Value implements driver.Valuer for database storage.
-// Stores as int64 Unix timestamp (UTC).
+// Stores as int64 Unix timestamp (UTC). A nil Zeit stores NULL.
 func (z *Zeit) Value() (driver.Value, error) {
+	if z == nil {
+		return nil, nil
+	}
 	return z.instant.Unix(), nil
 }
 
@@ -160,24 +501,31 @@ func (z *Zeit) Value() (driver.Value, error) {
 // Reads int64 Unix timestamp, defaults to UTC. Also normalizes float64
 // since some SQLite drivers deliver INTEGER columns as float64.
 // Use In() to switch to user timezone after scanning.
+// Returns ErrOutOfRange for timestamps outside the configured valid range.
 //
 // Struct fields should use *Zeit (not Zeit) so that driver.Valuer
 // is satisfied via the pointer receiver.
 func (z *Zeit) Scan(src any) error {
+	var seconds int64
 	switch v := src.(type) {
 	case int64:
-		z.instant = time.Unix(v, 0).UTC()
-		z.location = time.UTC
-		return nil
+		seconds = v
 	case float64:
-		z.instant = time.Unix(int64(v), 0).UTC()
-		z.location = time.UTC
-		return nil
+		seconds = int64(v)
 	case nil:
-		return fmt.Errorf("zeit: cannot scan nil value")
+		return fmt.Errorf("%w: cannot scan NULL into Zeit", ErrNilValue)
 	default:
-		return fmt.Errorf("zeit: cannot scan %T into Zeit", src)
+		return fmt.Errorf("%w: cannot scan %T into Zeit", ErrUnsupportedScanType, src)
+	}
+
+	scanned := FromDatabase(seconds, time.UTC)
+	if err := scanned.Validate(); err != nil {
+		return err
 	}
+
+	z.instant = scanned.instant
+	z.location = scanned.location
+	return nil
 }
 
 // Until returns a Duration from z to other.
@@ -197,6 +545,30 @@ func (z *Zeit) DayOfMonth() int {
 	return z.instant.In(z.location).Day()
 }
 
+// DayOfYear returns the day of the year (1-365, or 366 in leap years).
+func (z *Zeit) DayOfYear() int {
+	return z.instant.In(z.location).YearDay()
+}
+
+// IsLeapYear reports whether the Zeit's year is a leap year.
+func (z *Zeit) IsLeapYear() bool {
+	return daysIn(z.instant.In(z.location).Year(), time.February) == 29
+}
+
+// DaysInYear returns the number of days in the Zeit's year (365 or 366).
+func (z *Zeit) DaysInYear() int {
+	if z.IsLeapYear() {
+		return 366
+	}
+	return 365
+}
+
+// FormatOrdinalDate formats the Zeit as an ISO 8601 ordinal date in its timezone,
+// year and day of year, e.g. "2024-046" for Feb 15, 2024.
+func (z *Zeit) FormatOrdinalDate() string {
+	return fmt.Sprintf("%04d-%03d", z.Time().Year(), z.DayOfYear())
+}
+
 // StartOfMonth returns a new Zeit at the first instant of the month (00:00:00 on day 1).
 func (z *Zeit) StartOfMonth() *Zeit {
 	t := z.instant.In(z.location)
@@ -204,14 +576,68 @@ func (z *Zeit) StartOfMonth() *Zeit {
 }
 
 // EndOfMonth returns a new Zeit at the last second of the month (23:59:59 on last day).
+// Instants within that final second, such as 23:59:59.5, lie after it; for range
+// checks use EndOfMonthExclusive as a half-open upper bound instead.
 func (z *Zeit) EndOfMonth() *Zeit {
 	t := z.instant.In(z.location)
 	lastDay := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, z.location).Day()
 	return New(time.Date(t.Year(), t.Month(), lastDay, 23, 59, 59, 0, z.location), z.location)
 }
 
-// MarshalJSON implements json.Marshaler.
+// EndOfMonthExclusive returns a new Zeit at the first instant of the next month
+// (00:00:00 on day 1), the exclusive end of the Zeit's month. Every instant of
+// the month, including 23:59:59.999999999 on its last day, is before it:
+//
+//	inMonth := !t.Before(z.StartOfMonth()) && t.Before(z.EndOfMonthExclusive())
+func (z *Zeit) EndOfMonthExclusive() *Zeit {
+	t := z.instant.In(z.location)
+	return New(time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, z.location), z.location)
+}
+
+// MonthPeriod returns the Zeit's calendar month in its timezone as a half-open Period,
+// from StartOfMonth to EndOfMonthExclusive, for use with Contains and Overlap.
+func (z *Zeit) MonthPeriod() *Period {
+	return &Period{StartsAt: z.StartOfMonth(), EndsAt: z.EndOfMonthExclusive()}
+}
+
+// DayPeriod returns the Zeit's calendar day in its timezone as a half-open Period,
+// from local midnight to the next local midnight. Days with a DST change are 23
+// or 25 hours long.
+func (z *Zeit) DayPeriod() *Period {
+	return &Period{StartsAt: z.StartOfDay(), EndsAt: z.addLocalDays(1)}
+}
+
+// StartOfDay returns a new Zeit at local midnight of the Zeit's day, in its timezone.
+func (z *Zeit) StartOfDay() *Zeit {
+	return z.addLocalDays(0)
+}
+
+// StartOfHour returns a new Zeit at the first instant of the hour in the Zeit's timezone.
+// Zones with half-hour offsets, such as Asia/Kolkata, get their own local hours.
+// During a DST fall-back the repeated hour is kept apart from the first one.
+func (z *Zeit) StartOfHour() *Zeit {
+	t := z.Time()
+	elapsed := time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+	return New(z.instant.Add(-elapsed), z.location)
+}
+
+// EndOfHour returns a new Zeit at the last second of the hour (xx:59:59) in the Zeit's timezone.
+func (z *Zeit) EndOfHour() *Zeit {
+	return z.StartOfHour().Add(59*time.Minute + 59*time.Second)
+}
+
+// StartOfMinute returns a new Zeit at the first instant of the minute in the Zeit's timezone.
+func (z *Zeit) StartOfMinute() *Zeit {
+	t := z.Time()
+	elapsed := time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+	return New(z.instant.Add(-elapsed), z.location)
+}
+
+// MarshalJSON implements json.Marshaler. A nil Zeit marshals to null.
 func (z *Zeit) MarshalJSON() ([]byte, error) {
+	if z == nil {
+		return []byte("null"), nil
+	}
 	return json.Marshal(z.ToUser())
 }
 
@@ -232,3 +658,67 @@ func (z *Zeit) UnmarshalJSON(data []byte) error {
 	z.location = parsed.location
 	return nil
 }
+
+// MarshalGQL implements graphql.Marshaler (gqlgen) for use as a custom scalar.
+// Writes an RFC3339 string in the Zeit's timezone, or null for a nil Zeit.
+func (z *Zeit) MarshalGQL(w io.Writer) {
+	if z == nil {
+		_, _ = io.WriteString(w, "null")
+		return
+	}
+	_, _ = io.WriteString(w, strconv.Quote(z.ToUser()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler (gqlgen) for use as a custom scalar.
+// Accepts an RFC3339 string, defaults to UTC.
+func (z *Zeit) UnmarshalGQL(v any) error {
+	isoString, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("%w: cannot unmarshal %T into Zeit, expected RFC3339 string", ErrInvalidFormat, v)
+	}
+
+	parsed, err := FromUser(isoString, time.UTC)
+	if err != nil {
+		return err
+	}
+
+	z.instant = parsed.instant
+	z.location = parsed.location
+	return nil
+}
+
+// addLocalDays returns local midnight days after the Zeit's local date, so DST
+// changes in between don't shift the result off midnight.
+func (z *Zeit) addLocalDays(days int) *Zeit {
+	t := z.Time()
+	return New(time.Date(t.Year(), t.Month(), t.Day()+days, 0, 0, 0, 0, z.location), z.location)
+}
+
+// localTime is like time.Date but resolves wall-clock times around DST changes
+// deterministically, where time.Date leaves the choice open: a skipped time is read
+// with the offset before the change, moving it forward by the gap, and a repeated
+// time resolves to its earlier instant. Assumes at most one offset change within
+// a day of the requested time.
+func localTime(year int, month time.Month, day, hour, minute, sec, nsec int, loc *time.Location) time.Time {
+	wall := time.Date(year, month, day, hour, minute, sec, nsec, time.UTC)
+	_, before := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(24 * time.Hour).In(loc).Zone()
+
+	for _, offset := range []int{max(before, after), min(before, after)} {
+		t := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if _, actual := t.Zone(); actual == offset {
+			return t
+		}
+	}
+	return wall.Add(-time.Duration(before) * time.Second).In(loc)
+}
+
+// startOfDay returns midnight of t's calendar day in t's location.
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// daysIn returns the number of days in the given month.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
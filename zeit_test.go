@@ -1,8 +1,12 @@
 package zeit
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"math"
 	"testing"
+	"text/template"
 	"time"
 )
 
@@ -32,6 +36,45 @@ func TestNew_NilLocation(t *testing.T) {
 	}
 }
 
+func TestDate(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		loc      *time.Location
+		expected time.Time
+		name     string
+		month    time.Month
+		year     int
+		day      int
+		hour     int
+		minute   int
+	}{
+		{name: "UTC", loc: time.UTC, year: 2024, month: time.March, day: 15, hour: 9, minute: 30, expected: time.Date(2024, 3, 15, 9, 30, 0, 0, time.UTC)},
+		{name: "Berlin winter", loc: berlin, year: 2024, month: time.January, day: 15, hour: 9, expected: time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC)},
+		{name: "Berlin summer", loc: berlin, year: 2024, month: time.July, day: 15, hour: 9, expected: time.Date(2024, 7, 15, 7, 0, 0, 0, time.UTC)},
+		{name: "Normalized", loc: time.UTC, year: 2024, month: time.October, day: 32, expected: time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Skipped by DST", loc: berlin, year: 2024, month: time.March, day: 31, hour: 2, minute: 30, expected: time.Date(2024, 3, 31, 1, 30, 0, 0, time.UTC)},
+		{name: "Repeated by DST", loc: berlin, year: 2024, month: time.October, day: 27, hour: 2, minute: 30, expected: time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC)},
+		{name: "Nil location", loc: nil, year: 2024, month: time.March, day: 15, expected: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := Date(tt.year, tt.month, tt.day, tt.hour, tt.minute, 0, 0, tt.loc)
+			if !z.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, z.instant)
+			}
+			want := tt.loc
+			if want == nil {
+				want = time.UTC
+			}
+			if z.location != want {
+				t.Errorf("Expected location %v, got %v", want, z.location)
+			}
+		})
+	}
+}
+
 func TestNow(t *testing.T) {
 	before := time.Now()
 	z := Now(time.UTC)
@@ -47,6 +90,38 @@ func TestNow(t *testing.T) {
 	}
 }
 
+func TestTodayYesterdayTomorrow(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	now := time.Now().In(tokyo)
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, tokyo)
+
+	tests := []struct {
+		got      *Zeit
+		expected time.Time
+		name     string
+	}{
+		{name: "Today", got: Today(tokyo), expected: midnight},
+		{name: "Yesterday", got: Yesterday(tokyo), expected: midnight.AddDate(0, 0, -1)},
+		{name: "Tomorrow", got: Tomorrow(tokyo), expected: midnight.AddDate(0, 0, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Allow for the day changing between time.Now and the call
+			if !tt.got.instant.Equal(tt.expected) && !tt.got.instant.Equal(tt.expected.AddDate(0, 0, 1)) {
+				t.Errorf("Expected %v, got %v", tt.expected, tt.got.Time())
+			}
+			if tt.got.Location() != tokyo {
+				t.Error("Result should be in the requested location")
+			}
+		})
+	}
+
+	if Today(nil).Location() != time.UTC {
+		t.Error("Nil location should default to UTC")
+	}
+}
+
 func TestFromUser(t *testing.T) {
 	tests := []struct {
 		checkFunc func(*Zeit) error
@@ -112,6 +187,59 @@ func TestFromUser(t *testing.T) {
 	}
 }
 
+func TestFromDateTimeLocal(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		expected time.Time
+		input    string
+		name     string
+	}{
+		{name: "Minutes", input: "2024-01-15T10:30", expected: time.Date(2024, 1, 15, 10, 30, 0, 0, berlin)},
+		{name: "Seconds", input: "2024-01-15T10:30:45", expected: time.Date(2024, 1, 15, 10, 30, 45, 0, berlin)},
+		{name: "Fractional seconds", input: "2024-07-15T10:30:45.5", expected: time.Date(2024, 7, 15, 10, 30, 45, 500000000, berlin)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z, err := FromDateTimeLocal(tt.input, berlin)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !z.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, z.Time())
+			}
+			if z.Location() != berlin {
+				t.Error("Result should be in the given location")
+			}
+		})
+	}
+}
+
+func TestFromDateTimeLocal_Invalid(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		loc   *time.Location
+		input string
+		name  string
+	}{
+		{name: "With offset", input: "2024-01-15T10:30Z", loc: berlin},
+		{name: "Date only", input: "2024-01-15", loc: berlin},
+		{name: "Space separator", input: "2024-01-15 10:30", loc: berlin},
+		{name: "Invalid hour", input: "2024-01-15T25:30", loc: berlin},
+		{name: "Nil location", input: "2024-01-15T10:30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := FromDateTimeLocal(tt.input, tt.loc); err == nil {
+				t.Error("Expected error")
+			}
+		})
+	}
+}
+
 func TestFromDatabase(t *testing.T) {
 	timestamp := int64(1705318200) // 2024-01-15 10:30:00 UTC
 	z := FromDatabase(timestamp, time.UTC)
@@ -187,6 +315,35 @@ func TestToUser(t *testing.T) {
 	}
 }
 
+func TestToUserPrecision(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := New(time.Date(2024, 1, 15, 9, 30, 45, 123456789, time.UTC), berlin)
+
+	tests := []struct {
+		zeit      *Zeit
+		name      string
+		expected  string
+		precision Precision
+	}{
+		{name: "Seconds", zeit: z, precision: PrecisionSecond, expected: "2024-01-15T10:30:45+01:00"},
+		{name: "Minutes", zeit: z, precision: PrecisionMinute, expected: "2024-01-15T10:30+01:00"},
+		{name: "Millis truncate", zeit: z, precision: PrecisionMilli, expected: "2024-01-15T10:30:45.123+01:00"},
+		{name: "Micros", zeit: z, precision: PrecisionMicro, expected: "2024-01-15T10:30:45.123456+01:00"},
+		{name: "Nanos", zeit: z, precision: PrecisionNano, expected: "2024-01-15T10:30:45.123456789+01:00"},
+		{name: "Millis keep zeros", zeit: New(time.Date(2024, 1, 15, 9, 30, 45, 0, time.UTC), time.UTC), precision: PrecisionMilli, expected: "2024-01-15T09:30:45.000Z"},
+		{name: "Unknown precision", zeit: z, precision: Precision(99), expected: "2024-01-15T10:30:45+01:00"},
+		{name: "Nil Zeit", precision: PrecisionMilli, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.zeit.ToUserPrecision(tt.precision); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
 func TestAdd(t *testing.T) {
 	base := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
 	z := New(base, time.UTC)
@@ -228,6 +385,144 @@ func TestAddDays_Negative(t *testing.T) {
 	}
 }
 
+func TestSub(t *testing.T) {
+	z := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), time.UTC)
+
+	result := z.Sub(90 * time.Minute)
+
+	expected := time.Date(2024, 1, 15, 8, 30, 0, 0, time.UTC)
+	if !result.instant.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, result.instant)
+	}
+	if !z.Sub(-time.Hour).Equal(z.Add(time.Hour)) {
+		t.Error("Sub of a negative duration should add")
+	}
+}
+
+func TestSubDaysAddWeeks(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), berlin)
+
+	tests := []struct {
+		result   *Zeit
+		expected time.Time
+		name     string
+	}{
+		{name: "SubDays", result: z.SubDays(5), expected: time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC)},
+		{name: "SubDays negative", result: z.SubDays(-1), expected: time.Date(2024, 1, 16, 10, 0, 0, 0, time.UTC)},
+		{name: "AddWeeks", result: z.AddWeeks(2), expected: time.Date(2024, 1, 29, 10, 0, 0, 0, time.UTC)},
+		{name: "AddWeeks negative", result: z.AddWeeks(-3), expected: time.Date(2023, 12, 25, 10, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.result.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, tt.result.instant)
+			}
+			if tt.result.Location() != berlin {
+				t.Error("Result should keep the timezone")
+			}
+		})
+	}
+}
+
+func TestAddDate(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	ny, _ := time.LoadLocation("America/New_York")
+
+	tests := []struct {
+		start    time.Time
+		expected time.Time
+		name     string
+		years    int
+		months   int
+		days     int
+	}{
+		{name: "Day across DST keeps wall clock", start: time.Date(2024, 3, 30, 0, 0, 0, 0, berlin), days: 1, expected: time.Date(2024, 3, 31, 0, 0, 0, 0, berlin)},
+		{name: "Month across DST keeps wall clock", start: time.Date(2024, 3, 15, 9, 0, 0, 0, berlin), months: 1, expected: time.Date(2024, 4, 15, 9, 0, 0, 0, berlin)},
+		{name: "Month end clamps", start: time.Date(2024, 1, 31, 10, 0, 0, 0, berlin), months: 1, expected: time.Date(2024, 2, 29, 10, 0, 0, 0, berlin)},
+		{name: "Clamp before days", start: time.Date(2024, 1, 31, 10, 0, 0, 0, berlin), months: 1, days: 1, expected: time.Date(2024, 3, 1, 10, 0, 0, 0, berlin)},
+		{name: "Leap day plus a year", start: time.Date(2024, 2, 29, 0, 0, 0, 0, berlin), years: 1, expected: time.Date(2025, 2, 28, 0, 0, 0, 0, berlin)},
+		{name: "Negative", start: time.Date(2024, 3, 31, 10, 0, 0, 0, berlin), months: -1, days: -1, expected: time.Date(2024, 2, 28, 10, 0, 0, 0, berlin)},
+		{name: "Skipped time moves forward in Berlin", start: time.Date(2024, 3, 30, 2, 30, 0, 0, berlin), days: 1, expected: time.Date(2024, 3, 31, 1, 30, 0, 0, time.UTC)},
+		{name: "Skipped time moves forward in New York", start: time.Date(2024, 3, 9, 2, 30, 0, 0, ny), days: 1, expected: time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC)},
+		{name: "Repeated time is the earlier in Berlin", start: time.Date(2024, 10, 26, 2, 30, 0, 0, berlin), days: 1, expected: time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC)},
+		{name: "Repeated time is the earlier in New York", start: time.Date(2024, 11, 2, 1, 30, 0, 0, ny), days: 1, expected: time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := New(tt.start, tt.start.Location())
+			result := z.AddDate(tt.years, tt.months, tt.days)
+			if !result.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected.In(z.location), result.Time())
+			}
+			if result.Location() != z.Location() {
+				t.Error("Result should keep the timezone")
+			}
+		})
+	}
+}
+
+func TestAddDays_DSTIsAbsolute(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := New(time.Date(2024, 3, 30, 12, 0, 0, 0, berlin), berlin)
+
+	if got := z.AddDays(1); !got.Equal(z.Add(24*time.Hour)) || got.Time().Hour() != 13 {
+		t.Errorf("Expected 24 hours later at 13:00, got %v", got.ToUser())
+	}
+	if got := z.AddDate(0, 0, 1); got.Time().Hour() != 12 {
+		t.Errorf("Expected 12:00, got %v", got.ToUser())
+	}
+}
+
+func TestAddChecked(t *testing.T) {
+	z := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), time.UTC)
+
+	result, err := z.AddChecked(2 * time.Hour)
+	if err != nil {
+		t.Fatalf("AddChecked error: %v", err)
+	}
+	if !result.Equal(z.Add(2 * time.Hour)) {
+		t.Errorf("AddChecked should match Add in range, got %v", result.instant)
+	}
+
+	far := New(time.Unix(math.MaxInt64-62135596800-60, 0), time.UTC)
+	if _, err := far.AddChecked(time.Hour); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Expected ErrOutOfRange near the maximum time, got %v", err)
+	}
+}
+
+func TestAddDaysChecked(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), berlin)
+
+	result, err := z.AddDaysChecked(-30)
+	if err != nil {
+		t.Fatalf("AddDaysChecked error: %v", err)
+	}
+	if !result.Equal(z.AddDays(-30)) || result.Location() != berlin {
+		t.Errorf("AddDaysChecked should match AddDays in range, got %v", result.instant)
+	}
+
+	tests := []struct {
+		name string
+		days int
+	}{
+		{"Beyond int64 seconds", math.MaxInt64 / 1000},
+		{"Far future wraparound", math.MaxInt64 / 86400},
+		{"Far past wraparound", math.MinInt64/86400 - 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := z.AddDaysChecked(tt.days); !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("Expected ErrOutOfRange, got %v", err)
+			}
+		})
+	}
+}
+
 func TestAddBusinessDays(t *testing.T) {
 	tests := []struct {
 		start    time.Time
@@ -358,6 +653,190 @@ func TestEqual(t *testing.T) {
 	}
 }
 
+func TestEqualWithin(t *testing.T) {
+	base := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), time.UTC)
+	ny, _ := time.LoadLocation("America/New_York")
+
+	tests := []struct {
+		other     *Zeit
+		name      string
+		tolerance time.Duration
+		expected  bool
+	}{
+		{name: "Same instant", other: base.In(ny), tolerance: 0, expected: true},
+		{name: "Within tolerance later", other: base.Add(2 * time.Second), tolerance: 5 * time.Second, expected: true},
+		{name: "Within tolerance earlier", other: base.Add(-5 * time.Second), tolerance: 5 * time.Second, expected: true},
+		{name: "Outside tolerance", other: base.Add(6 * time.Second), tolerance: 5 * time.Second, expected: false},
+		{name: "Negative tolerance", other: base.Add(-3 * time.Second), tolerance: -5 * time.Second, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := base.EqualWithin(tt.other, tt.tolerance); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+			if got := tt.other.EqualWithin(base, tt.tolerance); got != tt.expected {
+				t.Errorf("Expected symmetric result %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSameDayMonthYear(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	tests := []struct {
+		a, b      time.Time
+		loc       *time.Location
+		name      string
+		sameDay   bool
+		sameMonth bool
+		sameYear  bool
+	}{
+		{
+			name:      "Same day",
+			a:         time.Date(2024, 3, 15, 8, 0, 0, 0, time.UTC),
+			b:         time.Date(2024, 3, 15, 20, 0, 0, 0, time.UTC),
+			loc:       time.UTC,
+			sameDay:   true,
+			sameMonth: true,
+			sameYear:  true,
+		},
+		{
+			name:      "Same day in UTC, different in Tokyo",
+			a:         time.Date(2024, 3, 15, 8, 0, 0, 0, time.UTC),
+			b:         time.Date(2024, 3, 15, 20, 0, 0, 0, time.UTC),
+			loc:       tokyo,
+			sameMonth: true,
+			sameYear:  true,
+		},
+		{
+			name:     "Month boundary in Berlin",
+			a:        time.Date(2024, 3, 31, 21, 0, 0, 0, time.UTC),
+			b:        time.Date(2024, 3, 31, 23, 0, 0, 0, time.UTC),
+			loc:      berlin,
+			sameYear: true,
+		},
+		{
+			name: "Same day number, different year",
+			a:    time.Date(2023, 3, 15, 12, 0, 0, 0, time.UTC),
+			b:    time.Date(2024, 3, 14, 12, 0, 0, 0, time.UTC),
+			loc:  time.UTC,
+		},
+		{
+			name: "Nil location uses z's timezone",
+			a:    time.Date(2023, 12, 31, 16, 0, 0, 0, time.UTC),
+			b:    time.Date(2023, 12, 31, 14, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := New(tt.a, tokyo)
+			b := New(tt.b, berlin)
+
+			if got := a.SameDay(b, tt.loc); got != tt.sameDay {
+				t.Errorf("Expected SameDay %v, got %v", tt.sameDay, got)
+			}
+			if got := a.SameMonth(b, tt.loc); got != tt.sameMonth {
+				t.Errorf("Expected SameMonth %v, got %v", tt.sameMonth, got)
+			}
+			if got := a.SameYear(b, tt.loc); got != tt.sameYear {
+				t.Errorf("Expected SameYear %v, got %v", tt.sameYear, got)
+			}
+		})
+	}
+}
+
+func TestCalendarDaysBetween(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	ny, _ := time.LoadLocation("America/New_York")
+	at := func(month, day, hour, minute int) *Zeit {
+		return New(time.Date(2024, time.Month(month), day, hour, minute, 0, 0, berlin), berlin)
+	}
+
+	tests := []struct {
+		a        *Zeit
+		b        *Zeit
+		loc      *time.Location
+		name     string
+		expected int
+	}{
+		{name: "Across midnight", a: at(1, 15, 23, 0), b: at(1, 16, 1, 0), expected: 1},
+		{name: "Same day", a: at(1, 15, 1, 0), b: at(1, 15, 23, 0), expected: 0},
+		{name: "Just under two days", a: at(1, 15, 0, 0), b: at(1, 17, 23, 59), expected: 2},
+		{name: "Across DST", a: at(3, 30, 12, 0), b: at(4, 2, 12, 0), expected: 3},
+		{name: "Across months", a: at(1, 31, 20, 0), b: at(3, 1, 8, 0), expected: 30},
+		{name: "Reversed", a: at(1, 16, 1, 0), b: at(1, 15, 23, 0), expected: -1},
+		{name: "Counted in another zone", a: at(1, 15, 23, 0), b: at(1, 16, 1, 0), loc: ny, expected: 0},
+		{name: "Nil loc uses a's timezone", a: at(1, 15, 23, 0), b: at(1, 16, 1, 0).In(ny), expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CalendarDaysBetween(tt.a, tt.b, tt.loc); got != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNights(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	at := func(day, hour int) *Zeit { return New(time.Date(2024, 3, day, hour, 0, 0, 0, berlin), berlin) }
+
+	tests := []struct {
+		checkIn  *Zeit
+		checkOut *Zeit
+		loc      *time.Location
+		name     string
+		expected int
+	}{
+		{name: "Regular stay", checkIn: at(15, 15), checkOut: at(18, 11), expected: 3},
+		{name: "Late arrival", checkIn: at(15, 23), checkOut: at(16, 1), expected: 1},
+		{name: "Day use", checkIn: at(15, 9), checkOut: at(15, 17), expected: 0},
+		{name: "Across DST", checkIn: at(30, 15), checkOut: at(31, 11), expected: 1},
+		{name: "Check-out before check-in", checkIn: at(18, 11), checkOut: at(15, 15), expected: 0},
+		{name: "Hotel timezone", checkIn: at(15, 15), checkOut: at(15, 20), loc: tokyo, expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Nights(tt.checkIn, tt.checkOut, tt.loc); got != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestApproximatelyBefore(t *testing.T) {
+	base := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), time.UTC)
+
+	tests := []struct {
+		other     *Zeit
+		name      string
+		tolerance time.Duration
+		expected  bool
+	}{
+		{name: "Clearly before", other: base.Add(time.Minute), tolerance: 5 * time.Second, expected: true},
+		{name: "Within skew", other: base.Add(3 * time.Second), tolerance: 5 * time.Second, expected: false},
+		{name: "Exactly tolerance apart", other: base.Add(5 * time.Second), tolerance: 5 * time.Second, expected: false},
+		{name: "After other", other: base.Add(-time.Minute), tolerance: 5 * time.Second, expected: false},
+		{name: "Zero tolerance matches Before", other: base.Add(time.Nanosecond), tolerance: 0, expected: true},
+		{name: "Negative tolerance", other: base.Add(3 * time.Second), tolerance: -5 * time.Second, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := base.ApproximatelyBefore(tt.other, tt.tolerance); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
 func TestMarshalJSON(t *testing.T) {
 	z := New(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), time.UTC)
 
@@ -406,6 +885,40 @@ func TestJSON_RoundTrip(t *testing.T) {
 	}
 }
 
+func TestMarshalGQL(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := New(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), berlin)
+
+	var buf bytes.Buffer
+	z.MarshalGQL(&buf)
+
+	expected := `"2024-01-15T11:30:00+01:00"`
+	if buf.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, buf.String())
+	}
+}
+
+func TestUnmarshalGQL(t *testing.T) {
+	var z Zeit
+	if err := z.UnmarshalGQL("2024-01-15T11:30:00+01:00"); err != nil {
+		t.Fatalf("UnmarshalGQL error: %v", err)
+	}
+
+	expected := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	if !z.instant.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, z.instant)
+	}
+	if z.Location() != time.UTC {
+		t.Error("UnmarshalGQL should default to UTC")
+	}
+
+	for _, invalid := range []any{"not-a-date", int64(1705314600), nil} {
+		if err := z.UnmarshalGQL(invalid); err == nil {
+			t.Errorf("UnmarshalGQL(%v) should return error", invalid)
+		}
+	}
+}
+
 func TestIn(t *testing.T) {
 	base := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
 	z := New(base, time.UTC)
@@ -443,6 +956,32 @@ func TestIn_NilLocation(t *testing.T) {
 	}
 }
 
+func TestConvert(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	ny, _ := time.LoadLocation("America/New_York")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	// Mar 12, 2024: New York already on DST, Berlin not yet
+	z := New(time.Date(2024, 3, 12, 15, 0, 0, 0, time.UTC), time.UTC)
+
+	result := Convert(z, []*time.Location{berlin, ny, tokyo, nil})
+
+	expected := map[string]string{
+		"Europe/Berlin":    "2024-03-12T16:00:00+01:00",
+		"America/New_York": "2024-03-12T11:00:00-04:00",
+		"Asia/Tokyo":       "2024-03-13T00:00:00+09:00",
+		"UTC":              "2024-03-12T15:00:00Z",
+	}
+	if len(result) != len(expected) {
+		t.Fatalf("Expected %d zones, got %d", len(expected), len(result))
+	}
+	for zone, want := range expected {
+		if result[zone] != want {
+			t.Errorf("%s: expected %s, got %s", zone, want, result[zone])
+		}
+	}
+}
+
 func TestValue(t *testing.T) {
 	timestamp := int64(1705318200)
 	z := FromDatabase(timestamp, time.UTC)
@@ -573,6 +1112,58 @@ func TestDayOfMonth(t *testing.T) {
 	}
 }
 
+func TestDayOfYear(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	tests := []struct {
+		time     time.Time
+		loc      *time.Location
+		name     string
+		expected int
+	}{
+		{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC, "First day", 1},
+		{time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.UTC, "After leap day", 61},
+		{time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC), time.UTC, "Non-leap year", 60},
+		{time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), time.UTC, "Last day of leap year", 366},
+		// Dec 31 20:00 UTC is already New Year's Day in Tokyo
+		{time.Date(2023, 12, 31, 20, 0, 0, 0, time.UTC), tokyo, "Local date", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := New(tt.time, tt.loc).DayOfYear(); got != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsLeapYear(t *testing.T) {
+	tests := []struct {
+		year     int
+		expected bool
+	}{
+		{2024, true},
+		{2023, false},
+		{2000, true},
+		{1900, false},
+	}
+
+	for _, tt := range tests {
+		z := New(time.Date(tt.year, 6, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+		if got := z.IsLeapYear(); got != tt.expected {
+			t.Errorf("%d: expected IsLeapYear %v, got %v", tt.year, tt.expected, got)
+		}
+		expectedDays := 365
+		if tt.expected {
+			expectedDays = 366
+		}
+		if got := z.DaysInYear(); got != expectedDays {
+			t.Errorf("%d: expected %d days, got %d", tt.year, expectedDays, got)
+		}
+	}
+}
+
 func TestStartOfMonth(t *testing.T) {
 	z := New(time.Date(2024, 3, 15, 14, 30, 0, 0, time.UTC), time.UTC)
 	start := z.StartOfMonth()
@@ -596,6 +1187,144 @@ func TestEndOfMonth(t *testing.T) {
 	}
 }
 
+func TestStartOfDay(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		at       time.Time
+		expected time.Time
+		name     string
+	}{
+		{
+			name:     "Local date differs from UTC",
+			at:       time.Date(2024, 1, 15, 23, 30, 0, 0, time.UTC),
+			expected: time.Date(2024, 1, 16, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:     "DST change day",
+			at:       time.Date(2024, 3, 31, 15, 0, 0, 0, berlin),
+			expected: time.Date(2024, 3, 31, 0, 0, 0, 0, berlin),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := New(tt.at, berlin).StartOfDay()
+			if !got.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got.Time())
+			}
+		})
+	}
+}
+
+func TestEndOfMonthExclusive(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		at       time.Time
+		expected time.Time
+		name     string
+	}{
+		{
+			name:     "Leap February",
+			at:       time.Date(2024, 2, 10, 12, 0, 0, 0, berlin),
+			expected: time.Date(2024, 3, 1, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:     "December rolls into next year",
+			at:       time.Date(2024, 12, 31, 23, 59, 59, 500000000, berlin),
+			expected: time.Date(2025, 1, 1, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:     "Local month differs from UTC",
+			at:       time.Date(2024, 3, 31, 23, 30, 0, 0, time.UTC), // Apr 1 in Berlin
+			expected: time.Date(2024, 5, 1, 0, 0, 0, 0, berlin),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := New(tt.at, berlin)
+			end := z.EndOfMonthExclusive()
+
+			if !end.instant.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, end.Time())
+			}
+			if !z.Before(end) {
+				t.Error("The Zeit should be before the exclusive end of its month")
+			}
+			if end.Location() != berlin {
+				t.Error("EndOfMonthExclusive should preserve timezone")
+			}
+		})
+	}
+}
+
+func TestMonthPeriod(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := New(time.Date(2024, 1, 31, 23, 59, 59, 500000000, berlin), berlin)
+
+	p := z.MonthPeriod()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, berlin)
+	end := time.Date(2024, 2, 1, 0, 0, 0, 0, berlin)
+	if !p.StartsAt.instant.Equal(start) || !p.EndsAt.instant.Equal(end) {
+		t.Errorf("Expected %v to %v, got %v to %v", start, end, p.StartsAt.Time(), p.EndsAt.Time())
+	}
+	if !p.Contains(z) {
+		t.Error("Month period should contain the last instant of the month")
+	}
+	if p.Contains(p.EndsAt) {
+		t.Error("Month period should not contain the next month's start")
+	}
+	if !p.Equal(p.EndsAt.AddDays(-1).MonthPeriod()) {
+		t.Error("Instants in the same month should share the month period")
+	}
+}
+
+func TestDayPeriod(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		at       time.Time
+		name     string
+		duration time.Duration
+	}{
+		{name: "Regular day", at: time.Date(2024, 1, 15, 10, 0, 0, 0, berlin), duration: 24 * time.Hour},
+		{name: "Spring forward", at: time.Date(2024, 3, 31, 10, 0, 0, 0, berlin), duration: 23 * time.Hour},
+		{name: "Fall back", at: time.Date(2024, 10, 27, 10, 0, 0, 0, berlin), duration: 25 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := New(tt.at, berlin)
+			p := z.DayPeriod()
+
+			start := time.Date(tt.at.Year(), tt.at.Month(), tt.at.Day(), 0, 0, 0, 0, berlin)
+			if !p.StartsAt.instant.Equal(start) {
+				t.Errorf("Expected start %v, got %v", start, p.StartsAt.Time())
+			}
+			if p.Duration() != tt.duration {
+				t.Errorf("Expected %v, got %v", tt.duration, p.Duration())
+			}
+			if !p.Contains(z) {
+				t.Error("Day period should contain the Zeit")
+			}
+		})
+	}
+}
+
+func TestEndOfMonth_LastSecondEscapes(t *testing.T) {
+	boundary := New(time.Date(2024, 1, 31, 23, 59, 59, 500000000, time.UTC), time.UTC)
+
+	if !boundary.After(boundary.EndOfMonth()) {
+		t.Error("Expected 23:59:59.5 to lie after EndOfMonth")
+	}
+	if !boundary.Before(boundary.EndOfMonthExclusive()) {
+		t.Error("Expected 23:59:59.5 to lie before EndOfMonthExclusive")
+	}
+}
+
 func TestStartEndOfMonth_WithTimezone(t *testing.T) {
 	berlin, _ := time.LoadLocation("Europe/Berlin")
 	z := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), berlin)
@@ -714,3 +1443,182 @@ func TestMonthBoundaries(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatOrdinalDate(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	tests := []struct {
+		time     time.Time
+		loc      *time.Location
+		expected string
+	}{
+		{time.Date(2024, 2, 15, 10, 0, 0, 0, time.UTC), time.UTC, "2024-046"},
+		{time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), time.UTC, "2024-366"},
+		{time.Date(2023, 12, 31, 20, 0, 0, 0, time.UTC), tokyo, "2024-001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := New(tt.time, tt.loc).FormatOrdinalDate(); result != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestSubDayBoundaries(t *testing.T) {
+	kolkata, _ := time.LoadLocation("Asia/Kolkata")
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tests := []struct {
+		time        time.Time
+		loc         *time.Location
+		startHour   time.Time
+		endHour     time.Time
+		startMinute time.Time
+		name        string
+	}{
+		{
+			name:        "UTC",
+			time:        time.Date(2024, 1, 15, 10, 42, 17, 500, time.UTC),
+			loc:         time.UTC,
+			startHour:   time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+			endHour:     time.Date(2024, 1, 15, 10, 59, 59, 0, time.UTC),
+			startMinute: time.Date(2024, 1, 15, 10, 42, 0, 0, time.UTC),
+		},
+		{
+			name:        "Half-hour offset",
+			time:        time.Date(2024, 1, 15, 10, 42, 17, 0, kolkata),
+			loc:         kolkata,
+			startHour:   time.Date(2024, 1, 15, 10, 0, 0, 0, kolkata),
+			endHour:     time.Date(2024, 1, 15, 10, 59, 59, 0, kolkata),
+			startMinute: time.Date(2024, 1, 15, 10, 42, 0, 0, kolkata),
+		},
+		{
+			name:        "Repeated hour on DST fall-back",
+			time:        time.Date(2024, 10, 27, 1, 30, 0, 0, time.UTC), // second 02:30 in Berlin
+			loc:         berlin,
+			startHour:   time.Date(2024, 10, 27, 1, 0, 0, 0, time.UTC),
+			endHour:     time.Date(2024, 10, 27, 1, 59, 59, 0, time.UTC),
+			startMinute: time.Date(2024, 10, 27, 1, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := New(tt.time, tt.loc)
+
+			if result := z.StartOfHour(); !result.instant.Equal(tt.startHour) {
+				t.Errorf("StartOfHour: expected %v, got %v", tt.startHour.UTC(), result.instant)
+			}
+			if result := z.EndOfHour(); !result.instant.Equal(tt.endHour) {
+				t.Errorf("EndOfHour: expected %v, got %v", tt.endHour.UTC(), result.instant)
+			}
+			if result := z.StartOfMinute(); !result.instant.Equal(tt.startMinute) {
+				t.Errorf("StartOfMinute: expected %v, got %v", tt.startMinute.UTC(), result.instant)
+			}
+			if z.StartOfHour().Location() != tt.loc {
+				t.Error("StartOfHour should preserve location")
+			}
+		})
+	}
+}
+
+func TestNilZeit(t *testing.T) {
+	var z *Zeit
+
+	if z.ToUser() != "" {
+		t.Error("ToUser on nil should return an empty string")
+	}
+	if z.Format(time.DateOnly) != "" {
+		t.Error("Format on nil should return an empty string")
+	}
+	if z.FormatLocalized("January 2nd", German) != "" {
+		t.Error("FormatLocalized on nil should return an empty string")
+	}
+
+	if value, err := z.Value(); value != nil || err != nil {
+		t.Errorf("Value on nil should return NULL, got %v, %v", value, err)
+	}
+
+	data, err := z.MarshalJSON()
+	if err != nil || string(data) != "null" {
+		t.Errorf("MarshalJSON on nil should return null, got %s, %v", data, err)
+	}
+
+	var buf bytes.Buffer
+	z.MarshalGQL(&buf)
+	if buf.String() != "null" {
+		t.Errorf("MarshalGQL on nil should write null, got %s", buf.String())
+	}
+}
+
+func TestNilZeit_Template(t *testing.T) {
+	tmpl := template.Must(template.New("invoice").Parse(`Paid: {{.PaidAt.ToUser}}`))
+	data := struct{ PaidAt *Zeit }{}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		t.Fatalf("Execute error: %v", err)
+	}
+	if buf.String() != "Paid: " {
+		t.Errorf("Expected blank timestamp, got %q", buf.String())
+	}
+}
+
+func TestClone(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := New(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), berlin)
+
+	clone := z.Clone()
+	if clone == z {
+		t.Error("Clone should return a new pointer")
+	}
+	if !clone.Equal(z) || clone.Location() != berlin {
+		t.Error("Clone should keep instant and location")
+	}
+
+	if err := clone.UnmarshalJSON([]byte(`"2030-01-01T00:00:00Z"`)); err != nil {
+		t.Fatalf("UnmarshalJSON error: %v", err)
+	}
+	if z.instant.Year() != 2024 {
+		t.Error("Decoding into a clone should not affect the original")
+	}
+
+	var nilZeit *Zeit
+	if nilZeit.Clone() != nil {
+		t.Error("Clone of nil should be nil")
+	}
+}
+
+func TestImmutability(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := New(time.Date(2024, 1, 31, 10, 30, 0, 0, time.UTC), berlin)
+	snapshot := *z
+
+	_ = z.Add(time.Hour)
+	_ = z.AddDays(3)
+	_ = z.AddBusinessDays(2)
+	_, _ = z.AddChecked(time.Hour)
+	_, _ = z.AddDaysChecked(3)
+	_ = z.AddSpan(Span(1, Months))
+	_ = z.AddDuration(z.Until(z.AddDays(1)))
+	_ = z.SubDuration(z.Until(z.AddDays(1)))
+	_ = z.In(time.UTC)
+	_ = z.StartOfMonth()
+	_ = z.EndOfMonth()
+	_ = z.StartOfHour()
+	_ = z.EndOfHour()
+	_ = z.StartOfMinute()
+	_ = z.StartOfWeek(ISOWeek)
+	_ = z.Clamp()
+	_ = z.Cycles(3, Monthly)
+	_ = z.CyclesAnchoredWeekly(3, time.Monday)
+	_ = z.CyclesEvery(3, Span(1, Weeks))
+	_ = z.SlidingWindows(time.Hour, time.Minute, 3)
+	_, _ = NewCalendar().AddBusinessDays(z, 5)
+
+	if *z != snapshot {
+		t.Errorf("Methods modified the receiver: %v, want %v", *z, snapshot)
+	}
+}
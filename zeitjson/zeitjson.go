@@ -0,0 +1,114 @@
+// Package zeitjson provides JSON wrapper types for zeit.Zeit that fix the wire
+// format per struct field, so API structs mixing RFC3339 strings and epoch
+// numbers don't need custom marshalers.
+//
+// Each type embeds *zeit.Zeit, so all Zeit methods are available on the field.
+// A nil Zeit marshals as JSON null, and null unmarshals to a nil Zeit.
+// Unmarshaling checks every format against the range set with zeit.SetValidRange
+// and returns zeit.ErrOutOfRange for values outside it.
+package zeitjson
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dnl-fm/zeit-go"
+)
+
+// null is the JSON null literal.
+const null = "null"
+
+// RFC3339 marshals as an RFC3339 string in the Zeit's timezone,
+// e.g. "2024-01-15T10:30:00+01:00". Unmarshaled values are in UTC.
+type RFC3339 struct {
+	*zeit.Zeit
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t RFC3339) MarshalJSON() ([]byte, error) {
+	if t.Zeit == nil {
+		return []byte(null), nil
+	}
+	return t.Zeit.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *RFC3339) UnmarshalJSON(data []byte) error {
+	if string(data) == null {
+		t.Zeit = nil
+		return nil
+	}
+
+	var z zeit.Zeit
+	if err := z.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	t.Zeit = &z
+	return nil
+}
+
+// EpochSeconds marshals as a JSON number of seconds since the Unix epoch,
+// e.g. 1705312800. Unmarshaled values are in UTC.
+type EpochSeconds struct {
+	*zeit.Zeit
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t EpochSeconds) MarshalJSON() ([]byte, error) {
+	if t.Zeit == nil {
+		return []byte(null), nil
+	}
+	return json.Marshal(t.Unix())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *EpochSeconds) UnmarshalJSON(data []byte) error {
+	if string(data) == null {
+		t.Zeit = nil
+		return nil
+	}
+
+	var seconds int64
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return err
+	}
+	z := zeit.FromDatabase(seconds, time.UTC)
+	if err := z.Validate(); err != nil {
+		return err
+	}
+	t.Zeit = z
+	return nil
+}
+
+// EpochMillis marshals as a JSON number of milliseconds since the Unix epoch,
+// e.g. 1705312800000. Unmarshaled values are in UTC.
+type EpochMillis struct {
+	*zeit.Zeit
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t EpochMillis) MarshalJSON() ([]byte, error) {
+	if t.Zeit == nil {
+		return []byte(null), nil
+	}
+	return json.Marshal(t.Time().UnixMilli())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *EpochMillis) UnmarshalJSON(data []byte) error {
+	if string(data) == null {
+		t.Zeit = nil
+		return nil
+	}
+
+	var millis int64
+	if err := json.Unmarshal(data, &millis); err != nil {
+		return err
+	}
+	z := zeit.New(time.UnixMilli(millis), time.UTC)
+	if err := z.Validate(); err != nil {
+		return err
+	}
+	t.Zeit = z
+	return nil
+}
@@ -0,0 +1,119 @@
+package zeitjson
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dnl-fm/zeit-go"
+)
+
+type event struct {
+	CreatedAt RFC3339      `json:"created_at"`
+	ExpiresAt EpochSeconds `json:"expires_at"`
+	SeenAt    EpochMillis  `json:"seen_at"`
+}
+
+func TestMarshal_MixedFormats(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	z := zeit.New(time.Date(2024, 1, 15, 10, 0, 0, 500000000, time.UTC), berlin)
+
+	data, err := json.Marshal(event{
+		CreatedAt: RFC3339{z},
+		ExpiresAt: EpochSeconds{z},
+		SeenAt:    EpochMillis{z},
+	})
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+
+	expected := `{"created_at":"2024-01-15T11:00:00+01:00","expires_at":1705312800,"seen_at":1705312800500}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+}
+
+func TestUnmarshal_MixedFormats(t *testing.T) {
+	input := `{"created_at":"2024-01-15T11:00:00+01:00","expires_at":1705312800,"seen_at":1705312800500}`
+
+	var e event
+	if err := json.Unmarshal([]byte(input), &e); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+
+	expected := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	if !e.CreatedAt.Time().Equal(expected) {
+		t.Errorf("CreatedAt: expected %v, got %v", expected, e.CreatedAt.Time())
+	}
+	if !e.ExpiresAt.Time().Equal(expected) {
+		t.Errorf("ExpiresAt: expected %v, got %v", expected, e.ExpiresAt.Time())
+	}
+	if !e.SeenAt.Time().Equal(expected.Add(500 * time.Millisecond)) {
+		t.Errorf("SeenAt: expected %v, got %v", expected.Add(500*time.Millisecond), e.SeenAt.Time())
+	}
+	if e.ExpiresAt.Location() != time.UTC {
+		t.Error("Unmarshaled values should default to UTC")
+	}
+}
+
+func TestNull(t *testing.T) {
+	data, err := json.Marshal(event{})
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+
+	expected := `{"created_at":null,"expires_at":null,"seen_at":null}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	var e event
+	if err := json.Unmarshal(data, &e); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if e.CreatedAt.Zeit != nil || e.ExpiresAt.Zeit != nil || e.SeenAt.Zeit != nil {
+		t.Error("null should unmarshal to a nil Zeit")
+	}
+}
+
+func TestUnmarshal_WrongType(t *testing.T) {
+	inputs := map[string]any{
+		`{"created_at":1705312800}`:             &event{},
+		`{"expires_at":"2024-01-15T10:00:00Z"}`: &event{},
+		`{"seen_at":"soon"}`:                    &event{},
+		`{"expires_at":1.5}`:                    &event{},
+	}
+
+	for input, target := range inputs {
+		if err := json.Unmarshal([]byte(input), target); err == nil {
+			t.Errorf("Unmarshal(%s) should return error", input)
+		}
+	}
+}
+
+func TestUnmarshal_ValidRange(t *testing.T) {
+	earliest := zeit.New(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), time.UTC)
+	if err := zeit.SetValidRange(earliest, nil); err != nil {
+		t.Fatalf("SetValidRange error: %v", err)
+	}
+	t.Cleanup(func() { _ = zeit.SetValidRange(nil, nil) })
+
+	inputs := []string{
+		`{"created_at":"1970-01-01T00:00:00Z"}`,
+		`{"expires_at":0}`,
+		`{"seen_at":0}`,
+	}
+
+	for _, input := range inputs {
+		var e event
+		if err := json.Unmarshal([]byte(input), &e); !errors.Is(err, zeit.ErrOutOfRange) {
+			t.Errorf("Unmarshal(%s): expected %v, got %v", input, zeit.ErrOutOfRange, err)
+		}
+	}
+
+	var e event
+	if err := json.Unmarshal([]byte(`{"expires_at":1705312800,"seen_at":1705312800500}`), &e); err != nil {
+		t.Errorf("Unmarshal of in-range values error: %v", err)
+	}
+}
@@ -0,0 +1,319 @@
+package zeit
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// abbreviationZone is one reading of a timezone abbreviation: the IANA zone it
+// means in a region, keyed by ISO 3166-1 alpha-2 country code.
+type abbreviationZone struct {
+	region string
+	zone   string
+}
+
+// abbreviationZones maps timezone abbreviations to their readings. The first
+// reading is the default used when the preferred region has none of its own.
+var abbreviationZones = map[string][]abbreviationZone{
+	"UTC":  {{"", "UTC"}},
+	"Z":    {{"", "UTC"}},
+	"GMT":  {{"", "UTC"}, {"GB", "Europe/London"}, {"IE", "Europe/Dublin"}},
+	"WET":  {{"PT", "Europe/Lisbon"}},
+	"WEST": {{"PT", "Europe/Lisbon"}},
+	"BST":  {{"GB", "Europe/London"}, {"BD", "Asia/Dhaka"}},
+	"IST":  {{"IN", "Asia/Kolkata"}, {"IE", "Europe/Dublin"}, {"IL", "Asia/Jerusalem"}},
+	"CET":  {{"DE", "Europe/Berlin"}},
+	"CEST": {{"DE", "Europe/Berlin"}},
+	"EET":  {{"GR", "Europe/Athens"}},
+	"EEST": {{"GR", "Europe/Athens"}},
+	"MSK":  {{"RU", "Europe/Moscow"}},
+	"AST":  {{"CA", "America/Halifax"}, {"SA", "Asia/Riyadh"}},
+	"ADT":  {{"CA", "America/Halifax"}},
+	"EST":  {{"US", "America/New_York"}, {"AU", "Australia/Sydney"}},
+	"EDT":  {{"US", "America/New_York"}},
+	"CST":  {{"US", "America/Chicago"}, {"CN", "Asia/Shanghai"}, {"CU", "America/Havana"}},
+	"CDT":  {{"US", "America/Chicago"}, {"CU", "America/Havana"}},
+	"MST":  {{"US", "America/Denver"}},
+	"MDT":  {{"US", "America/Denver"}},
+	"PST":  {{"US", "America/Los_Angeles"}, {"PH", "Asia/Manila"}},
+	"PDT":  {{"US", "America/Los_Angeles"}},
+	"AKST": {{"US", "America/Anchorage"}},
+	"AKDT": {{"US", "America/Anchorage"}},
+	"HST":  {{"US", "Pacific/Honolulu"}},
+	"JST":  {{"JP", "Asia/Tokyo"}},
+	"KST":  {{"KR", "Asia/Seoul"}},
+	"HKT":  {{"HK", "Asia/Hong_Kong"}},
+	"SGT":  {{"SG", "Asia/Singapore"}},
+	"AWST": {{"AU", "Australia/Perth"}},
+	"ACST": {{"AU", "Australia/Adelaide"}},
+	"ACDT": {{"AU", "Australia/Adelaide"}},
+	"AEST": {{"AU", "Australia/Sydney"}},
+	"AEDT": {{"AU", "Australia/Sydney"}},
+	"NZST": {{"NZ", "Pacific/Auckland"}},
+	"NZDT": {{"NZ", "Pacific/Auckland"}},
+}
+
+// ResolveAbbreviation maps a timezone abbreviation such as "CET" or "EST", as sent
+// by upstream feeds, to an IANA zone. Abbreviations are matched ignoring case.
+// The zone follows daylight saving time, so "EST" and "EDT" both resolve to
+// America/New_York: feeds rarely switch abbreviations with the season.
+//
+// Ambiguous abbreviations resolve by preferredRegion, an ISO 3166-1 alpha-2
+// country code, and otherwise to a documented default:
+//
+//	IST  India (Asia/Kolkata); IE: Europe/Dublin, IL: Asia/Jerusalem
+//	CST  US Central (America/Chicago); CN: Asia/Shanghai, CU: America/Havana
+//	BST  British Summer Time (Europe/London); BD: Asia/Dhaka
+//	EST  US Eastern (America/New_York); AU: Australia/Sydney
+//	PST  US Pacific (America/Los_Angeles); PH: Asia/Manila
+//	AST  Atlantic (America/Halifax); SA: Asia/Riyadh
+//	GMT  UTC; GB: Europe/London, IE: Europe/Dublin
+//
+// Returns ErrUnknownTimezone for abbreviations not in the table.
+func ResolveAbbreviation(abbr, preferredRegion string) (*time.Location, error) {
+	candidates, ok := abbreviationZones[strings.ToUpper(strings.TrimSpace(abbr))]
+	if !ok {
+		return nil, fmt.Errorf("%w: abbreviation %q", ErrUnknownTimezone, abbr)
+	}
+
+	zone := candidates[0].zone
+	for _, c := range candidates {
+		if c.region != "" && strings.EqualFold(c.region, preferredRegion) {
+			zone = c.zone
+			break
+		}
+	}
+	return LoadLocation(zone)
+}
+
+// windowsZones maps Windows timezone IDs to IANA zones, following the CLDR
+// windowsZones table for territory "001". Current IANA names are used where
+// CLDR keeps legacy ones, e.g. Asia/Kolkata rather than Asia/Calcutta.
+var windowsZones = map[string]string{
+	"Dateline Standard Time":          "Etc/GMT+12",
+	"UTC-11":                          "Etc/GMT+11",
+	"Aleutian Standard Time":          "America/Adak",
+	"Hawaiian Standard Time":          "Pacific/Honolulu",
+	"Marquesas Standard Time":         "Pacific/Marquesas",
+	"Alaskan Standard Time":           "America/Anchorage",
+	"UTC-09":                          "Etc/GMT+9",
+	"Pacific Standard Time (Mexico)":  "America/Tijuana",
+	"UTC-08":                          "Etc/GMT+8",
+	"Pacific Standard Time":           "America/Los_Angeles",
+	"US Mountain Standard Time":       "America/Phoenix",
+	"Mountain Standard Time (Mexico)": "America/Mazatlan",
+	"Mountain Standard Time":          "America/Denver",
+	"Yukon Standard Time":             "America/Whitehorse",
+	"Central America Standard Time":   "America/Guatemala",
+	"Central Standard Time":           "America/Chicago",
+	"Easter Island Standard Time":     "Pacific/Easter",
+	"Central Standard Time (Mexico)":  "America/Mexico_City",
+	"Canada Central Standard Time":    "America/Regina",
+	"SA Pacific Standard Time":        "America/Bogota",
+	"Eastern Standard Time (Mexico)":  "America/Cancun",
+	"Eastern Standard Time":           "America/New_York",
+	"Haiti Standard Time":             "America/Port-au-Prince",
+	"Cuba Standard Time":              "America/Havana",
+	"US Eastern Standard Time":        "America/Indiana/Indianapolis",
+	"Turks And Caicos Standard Time":  "America/Grand_Turk",
+	"Paraguay Standard Time":          "America/Asuncion",
+	"Atlantic Standard Time":          "America/Halifax",
+	"Venezuela Standard Time":         "America/Caracas",
+	"Central Brazilian Standard Time": "America/Cuiaba",
+	"SA Western Standard Time":        "America/La_Paz",
+	"Pacific SA Standard Time":        "America/Santiago",
+	"Newfoundland Standard Time":      "America/St_Johns",
+	"Tocantins Standard Time":         "America/Araguaina",
+	"E. South America Standard Time":  "America/Sao_Paulo",
+	"SA Eastern Standard Time":        "America/Cayenne",
+	"Argentina Standard Time":         "America/Argentina/Buenos_Aires",
+	"Greenland Standard Time":         "America/Nuuk",
+	"Montevideo Standard Time":        "America/Montevideo",
+	"Magallanes Standard Time":        "America/Punta_Arenas",
+	"Saint Pierre Standard Time":      "America/Miquelon",
+	"Bahia Standard Time":             "America/Bahia",
+	"UTC-02":                          "Etc/GMT+2",
+	"Azores Standard Time":            "Atlantic/Azores",
+	"Cape Verde Standard Time":        "Atlantic/Cape_Verde",
+	"UTC":                             "UTC",
+	"GMT Standard Time":               "Europe/London",
+	"Greenwich Standard Time":         "Atlantic/Reykjavik",
+	"Sao Tome Standard Time":          "Africa/Sao_Tome",
+	"Morocco Standard Time":           "Africa/Casablanca",
+	"W. Europe Standard Time":         "Europe/Berlin",
+	"Central Europe Standard Time":    "Europe/Budapest",
+	"Romance Standard Time":           "Europe/Paris",
+	"Central European Standard Time":  "Europe/Warsaw",
+	"W. Central Africa Standard Time": "Africa/Lagos",
+	"Jordan Standard Time":            "Asia/Amman",
+	"GTB Standard Time":               "Europe/Bucharest",
+	"Middle East Standard Time":       "Asia/Beirut",
+	"Egypt Standard Time":             "Africa/Cairo",
+	"E. Europe Standard Time":         "Europe/Chisinau",
+	"Syria Standard Time":             "Asia/Damascus",
+	"West Bank Standard Time":         "Asia/Hebron",
+	"South Africa Standard Time":      "Africa/Johannesburg",
+	"FLE Standard Time":               "Europe/Kyiv",
+	"Israel Standard Time":            "Asia/Jerusalem",
+	"South Sudan Standard Time":       "Africa/Juba",
+	"Kaliningrad Standard Time":       "Europe/Kaliningrad",
+	"Sudan Standard Time":             "Africa/Khartoum",
+	"Libya Standard Time":             "Africa/Tripoli",
+	"Namibia Standard Time":           "Africa/Windhoek",
+	"Arabic Standard Time":            "Asia/Baghdad",
+	"Turkey Standard Time":            "Europe/Istanbul",
+	"Arab Standard Time":              "Asia/Riyadh",
+	"Belarus Standard Time":           "Europe/Minsk",
+	"Russian Standard Time":           "Europe/Moscow",
+	"E. Africa Standard Time":         "Africa/Nairobi",
+	"Volgograd Standard Time":         "Europe/Volgograd",
+	"Iran Standard Time":              "Asia/Tehran",
+	"Arabian Standard Time":           "Asia/Dubai",
+	"Astrakhan Standard Time":         "Europe/Astrakhan",
+	"Azerbaijan Standard Time":        "Asia/Baku",
+	"Russia Time Zone 3":              "Europe/Samara",
+	"Mauritius Standard Time":         "Indian/Mauritius",
+	"Saratov Standard Time":           "Europe/Saratov",
+	"Georgian Standard Time":          "Asia/Tbilisi",
+	"Caucasus Standard Time":          "Asia/Yerevan",
+	"Afghanistan Standard Time":       "Asia/Kabul",
+	"West Asia Standard Time":         "Asia/Tashkent",
+	"Ekaterinburg Standard Time":      "Asia/Yekaterinburg",
+	"Pakistan Standard Time":          "Asia/Karachi",
+	"Qyzylorda Standard Time":         "Asia/Qyzylorda",
+	"India Standard Time":             "Asia/Kolkata",
+	"Sri Lanka Standard Time":         "Asia/Colombo",
+	"Nepal Standard Time":             "Asia/Kathmandu",
+	"Central Asia Standard Time":      "Asia/Bishkek",
+	"Bangladesh Standard Time":        "Asia/Dhaka",
+	"Omsk Standard Time":              "Asia/Omsk",
+	"Myanmar Standard Time":           "Asia/Yangon",
+	"SE Asia Standard Time":           "Asia/Bangkok",
+	"Altai Standard Time":             "Asia/Barnaul",
+	"W. Mongolia Standard Time":       "Asia/Hovd",
+	"North Asia Standard Time":        "Asia/Krasnoyarsk",
+	"N. Central Asia Standard Time":   "Asia/Novosibirsk",
+	"Tomsk Standard Time":             "Asia/Tomsk",
+	"China Standard Time":             "Asia/Shanghai",
+	"North Asia East Standard Time":   "Asia/Irkutsk",
+	"Singapore Standard Time":         "Asia/Singapore",
+	"W. Australia Standard Time":      "Australia/Perth",
+	"Taipei Standard Time":            "Asia/Taipei",
+	"Ulaanbaatar Standard Time":       "Asia/Ulaanbaatar",
+	"Aus Central W. Standard Time":    "Australia/Eucla",
+	"Transbaikal Standard Time":       "Asia/Chita",
+	"Tokyo Standard Time":             "Asia/Tokyo",
+	"North Korea Standard Time":       "Asia/Pyongyang",
+	"Korea Standard Time":             "Asia/Seoul",
+	"Yakutsk Standard Time":           "Asia/Yakutsk",
+	"Cen. Australia Standard Time":    "Australia/Adelaide",
+	"AUS Central Standard Time":       "Australia/Darwin",
+	"E. Australia Standard Time":      "Australia/Brisbane",
+	"AUS Eastern Standard Time":       "Australia/Sydney",
+	"West Pacific Standard Time":      "Pacific/Port_Moresby",
+	"Tasmania Standard Time":          "Australia/Hobart",
+	"Vladivostok Standard Time":       "Asia/Vladivostok",
+	"Lord Howe Standard Time":         "Australia/Lord_Howe",
+	"Bougainville Standard Time":      "Pacific/Bougainville",
+	"Russia Time Zone 10":             "Asia/Srednekolymsk",
+	"Magadan Standard Time":           "Asia/Magadan",
+	"Norfolk Standard Time":           "Pacific/Norfolk",
+	"Sakhalin Standard Time":          "Asia/Sakhalin",
+	"Central Pacific Standard Time":   "Pacific/Guadalcanal",
+	"Russia Time Zone 11":             "Asia/Kamchatka",
+	"New Zealand Standard Time":       "Pacific/Auckland",
+	"UTC+12":                          "Etc/GMT-12",
+	"Fiji Standard Time":              "Pacific/Fiji",
+	"Chatham Islands Standard Time":   "Pacific/Chatham",
+	"UTC+13":                          "Etc/GMT-13",
+	"Tonga Standard Time":             "Pacific/Tongatapu",
+	"Samoa Standard Time":             "Pacific/Apia",
+	"Line Islands Standard Time":      "Pacific/Kiritimati",
+}
+
+// ianaWindowsAliases maps IANA zones that are not the primary zone of a Windows
+// ID to that ID: other countries sharing the rules, and CLDR's legacy names.
+var ianaWindowsAliases = map[string]string{
+	"Etc/UTC":              "UTC",
+	"Europe/Dublin":        "GMT Standard Time",
+	"Europe/Lisbon":        "GMT Standard Time",
+	"Europe/Amsterdam":     "W. Europe Standard Time",
+	"Europe/Oslo":          "W. Europe Standard Time",
+	"Europe/Rome":          "W. Europe Standard Time",
+	"Europe/Stockholm":     "W. Europe Standard Time",
+	"Europe/Vienna":        "W. Europe Standard Time",
+	"Europe/Zurich":        "W. Europe Standard Time",
+	"Europe/Brussels":      "Romance Standard Time",
+	"Europe/Copenhagen":    "Romance Standard Time",
+	"Europe/Madrid":        "Romance Standard Time",
+	"Europe/Prague":        "Central Europe Standard Time",
+	"Europe/Athens":        "GTB Standard Time",
+	"Europe/Helsinki":      "FLE Standard Time",
+	"Europe/Riga":          "FLE Standard Time",
+	"Europe/Sofia":         "FLE Standard Time",
+	"Europe/Tallinn":       "FLE Standard Time",
+	"Europe/Vilnius":       "FLE Standard Time",
+	"Europe/Kiev":          "FLE Standard Time",
+	"America/Toronto":      "Eastern Standard Time",
+	"America/Detroit":      "Eastern Standard Time",
+	"America/Winnipeg":     "Central Standard Time",
+	"America/Edmonton":     "Mountain Standard Time",
+	"America/Vancouver":    "Pacific Standard Time",
+	"America/Godthab":      "Greenland Standard Time",
+	"America/Buenos_Aires": "Argentina Standard Time",
+	"America/Indianapolis": "US Eastern Standard Time",
+	"Asia/Calcutta":        "India Standard Time",
+	"Asia/Katmandu":        "Nepal Standard Time",
+	"Asia/Rangoon":         "Myanmar Standard Time",
+	"Asia/Saigon":          "SE Asia Standard Time",
+	"Asia/Ho_Chi_Minh":     "SE Asia Standard Time",
+	"Asia/Jakarta":         "SE Asia Standard Time",
+	"Asia/Hong_Kong":       "China Standard Time",
+	"Asia/Kuala_Lumpur":    "Singapore Standard Time",
+	"Asia/Manila":          "Singapore Standard Time",
+	"Australia/Melbourne":  "AUS Eastern Standard Time",
+	"Australia/Canberra":   "AUS Eastern Standard Time",
+}
+
+// ianaWindowsZones is the reverse of windowsZones, including ianaWindowsAliases.
+var ianaWindowsZones = func() map[string]string {
+	m := make(map[string]string, len(windowsZones)+len(ianaWindowsAliases))
+	for windows, iana := range windowsZones {
+		m[iana] = windows
+	}
+	for iana, windows := range ianaWindowsAliases {
+		m[iana] = windows
+	}
+	return m
+}()
+
+// FromWindowsZone converts a Windows timezone ID, as sent by Exchange and Outlook
+// calendar integrations, to its IANA zone using the CLDR mapping:
+//
+//	zeit.FromWindowsZone("W. Europe Standard Time")  // Europe/Berlin
+//
+// Returns ErrUnknownTimezone for IDs not in the table.
+func FromWindowsZone(name string) (*time.Location, error) {
+	iana, ok := windowsZones[strings.TrimSpace(name)]
+	if !ok {
+		return nil, fmt.Errorf("%w: Windows zone %q", ErrUnknownTimezone, name)
+	}
+	return LoadLocation(iana)
+}
+
+// WindowsZone returns the Windows timezone ID for loc, e.g. "W. Europe Standard Time"
+// for Europe/Berlin or Europe/Vienna. Zones other than the primary zone of a Windows
+// ID map to it only for common cases, so round-tripping through FromWindowsZone
+// yields a zone with the same rules, not necessarily loc itself.
+// Returns ErrUnknownTimezone for nil, Local and unmapped locations.
+func WindowsZone(loc *time.Location) (string, error) {
+	if loc == nil {
+		return "", fmt.Errorf("%w: nil location", ErrUnknownTimezone)
+	}
+	windows, ok := ianaWindowsZones[loc.String()]
+	if !ok {
+		return "", fmt.Errorf("%w: no Windows zone for %q", ErrUnknownTimezone, loc)
+	}
+	return windows, nil
+}
@@ -0,0 +1,148 @@
+package zeit
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestResolveAbbreviation(t *testing.T) {
+	tests := []struct {
+		abbr     string
+		region   string
+		expected string
+	}{
+		{abbr: "CET", region: "", expected: "Europe/Berlin"},
+		{abbr: "cest", region: "", expected: "Europe/Berlin"},
+		{abbr: "UTC", region: "US", expected: "UTC"},
+		{abbr: "IST", region: "", expected: "Asia/Kolkata"},
+		{abbr: "IST", region: "IE", expected: "Europe/Dublin"},
+		{abbr: "IST", region: "il", expected: "Asia/Jerusalem"},
+		{abbr: "IST", region: "US", expected: "Asia/Kolkata"},
+		{abbr: "CST", region: "", expected: "America/Chicago"},
+		{abbr: "CST", region: "CN", expected: "Asia/Shanghai"},
+		{abbr: "EST", region: "", expected: "America/New_York"},
+		{abbr: "EDT", region: "AU", expected: "America/New_York"},
+		{abbr: "BST", region: "BD", expected: "Asia/Dhaka"},
+		{abbr: "GMT", region: "", expected: "UTC"},
+		{abbr: "GMT", region: "GB", expected: "Europe/London"},
+		{abbr: " PST ", region: "PH", expected: "Asia/Manila"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.abbr+"/"+tt.region, func(t *testing.T) {
+			loc, err := ResolveAbbreviation(tt.abbr, tt.region)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if loc.String() != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, loc)
+			}
+		})
+	}
+}
+
+func TestResolveAbbreviation_Unknown(t *testing.T) {
+	for _, abbr := range []string{"XYZ", "", "Europe/Berlin"} {
+		if _, err := ResolveAbbreviation(abbr, ""); !errors.Is(err, ErrUnknownTimezone) {
+			t.Errorf("Expected %v for %q, got %v", ErrUnknownTimezone, abbr, err)
+		}
+	}
+}
+
+func TestResolveAbbreviation_TableLoads(t *testing.T) {
+	for abbr, candidates := range abbreviationZones {
+		for _, c := range candidates {
+			if _, err := LoadLocation(c.zone); err != nil {
+				t.Errorf("%s/%s: %v", abbr, c.region, err)
+			}
+		}
+	}
+}
+
+func TestFromWindowsZone(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "W. Europe Standard Time", expected: "Europe/Berlin"},
+		{name: "Romance Standard Time", expected: "Europe/Paris"},
+		{name: "GMT Standard Time", expected: "Europe/London"},
+		{name: "Eastern Standard Time", expected: "America/New_York"},
+		{name: "Pacific Standard Time", expected: "America/Los_Angeles"},
+		{name: "India Standard Time", expected: "Asia/Kolkata"},
+		{name: "UTC", expected: "UTC"},
+		{name: "UTC-11", expected: "Etc/GMT+11"},
+		{name: " Tokyo Standard Time ", expected: "Asia/Tokyo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := FromWindowsZone(tt.name)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if loc.String() != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, loc)
+			}
+		})
+	}
+}
+
+func TestWindowsZone(t *testing.T) {
+	tests := []struct {
+		zone     string
+		expected string
+	}{
+		{zone: "Europe/Berlin", expected: "W. Europe Standard Time"},
+		{zone: "Europe/Vienna", expected: "W. Europe Standard Time"},
+		{zone: "Europe/Madrid", expected: "Romance Standard Time"},
+		{zone: "America/Toronto", expected: "Eastern Standard Time"},
+		{zone: "Asia/Calcutta", expected: "India Standard Time"},
+		{zone: "Asia/Kolkata", expected: "India Standard Time"},
+		{zone: "UTC", expected: "UTC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			loc, _ := LoadLocation(tt.zone)
+			got, err := WindowsZone(loc)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWindowsZone_RoundTrip(t *testing.T) {
+	for windows := range windowsZones {
+		loc, err := FromWindowsZone(windows)
+		if err != nil {
+			t.Errorf("%s: %v", windows, err)
+			continue
+		}
+		got, err := WindowsZone(loc)
+		if err != nil || got != windows {
+			t.Errorf("Expected %v, got %v (%v)", windows, got, err)
+		}
+	}
+	for iana := range ianaWindowsAliases {
+		if _, err := LoadLocation(iana); err != nil {
+			t.Errorf("%s: %v", iana, err)
+		}
+	}
+}
+
+func TestWindowsZone_Unknown(t *testing.T) {
+	if _, err := FromWindowsZone("Mars Standard Time"); !errors.Is(err, ErrUnknownTimezone) {
+		t.Errorf("Expected %v, got %v", ErrUnknownTimezone, err)
+	}
+	for _, loc := range []*time.Location{nil, time.Local, time.FixedZone("X", 3600)} {
+		if _, err := WindowsZone(loc); !errors.Is(err, ErrUnknownTimezone) {
+			t.Errorf("Expected %v for %v, got %v", ErrUnknownTimezone, loc, err)
+		}
+	}
+}